@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &blockListsResource{}
+	_ resource.ResourceWithConfigure = &blockListsResource{}
+)
+
+// NewBlockListsResource is a helper function to simplify the provider implementation.
+func NewBlockListsResource() resource.Resource {
+	r := &blockListsResource{}
+	r.settingsResource = settingsResource[blockListsResourceModel]{
+		id:      "block_lists",
+		name:    "block lists",
+		set:     setBlockLists,
+		refresh: refreshBlockLists,
+		applied: r.downloadLists,
+	}
+	return r
+}
+
+// blockListsResource manages the URLs of the block and allow lists the
+// server downloads. The server keeps both in one list, with allow list URLs
+// prefixed by "!".
+type blockListsResource struct {
+	settingsResource[blockListsResourceModel]
+}
+
+// blockListsResourceModel maps the resource schema data.
+type blockListsResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	BlockListURLs       types.List   `tfsdk:"block_list_urls"`
+	AllowListURLs       types.List   `tfsdk:"allow_list_urls"`
+	UpdateIntervalHours types.Int32  `tfsdk:"update_interval_hours"`
+}
+
+// Metadata returns the resource type name.
+func (r *blockListsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_lists"
+}
+
+// Schema defines the schema for the resource.
+func (r *blockListsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	urls := func() schema.ListAttribute {
+		return schema.ListAttribute{
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(httpURL()),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"block_list_urls":       urls(),
+			"allow_list_urls":       urls(),
+			"update_interval_hours": settingInt32(int32validator.Between(1, 168)),
+		},
+	}
+}
+
+// setBlockLists adds the declared block lists to a set settings request.
+func setBlockLists(ctx context.Context, settings technitium.ApiSetSettingsRequest, plan blockListsResourceModel, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+	urls := stringListElements(ctx, plan.BlockListURLs, diags)
+	for _, url := range stringListElements(ctx, plan.AllowListURLs, diags) {
+		urls = append(urls, "!"+url)
+	}
+	settings = settings.BlockListUrls(urls)
+	if !plan.UpdateIntervalHours.IsNull() {
+		settings = settings.BlockListUrlUpdateIntervalHours(plan.UpdateIntervalHours.ValueInt32())
+	}
+	return settings
+}
+
+// refreshBlockLists copies the list URLs from the server, splitting them into block
+// and allow lists.
+func refreshBlockLists(ctx context.Context, model *blockListsResourceModel, settings *technitium.DnsSettings, diags *diag.Diagnostics) {
+	blockListURLs, allowListURLs := []string{}, []string{}
+	for _, url := range settings.BlockListUrls {
+		if allowListURL, ok := strings.CutPrefix(url, "!"); ok {
+			allowListURLs = append(allowListURLs, allowListURL)
+		} else {
+			blockListURLs = append(blockListURLs, url)
+		}
+	}
+	model.BlockListURLs = refreshList(ctx, model.BlockListURLs, blockListURLs, diags)
+	model.AllowListURLs = refreshList(ctx, model.AllowListURLs, allowListURLs, diags)
+	model.UpdateIntervalHours = refreshInt32(model.UpdateIntervalHours, settings.BlockListUrlUpdateIntervalHours)
+}
+
+// downloadLists downloads the lists when they are created or their URLs
+// change. Reordering the URLs does not download them again.
+func (r *blockListsResource) downloadLists(ctx context.Context, plan blockListsResourceModel, state *blockListsResourceModel, diags *diag.Diagnostics) {
+	if state == nil ||
+		!sameURLs(ctx, plan.BlockListURLs, state.BlockListURLs, diags) ||
+		!sameURLs(ctx, plan.AllowListURLs, state.AllowListURLs, diags) {
+		r.forceUpdate(ctx, diags)
+	}
+}
+
+// sameURLs reports whether two lists hold the same URLs in any order.
+func sameURLs(ctx context.Context, a types.List, b types.List, diags *diag.Diagnostics) bool {
+	urlsA, urlsB := stringListElements(ctx, a, diags), stringListElements(ctx, b, diags)
+	if len(urlsA) != len(urlsB) {
+		return false
+	}
+	sort.Strings(urlsA)
+	sort.Strings(urlsB)
+	for i := range urlsA {
+		if urlsA[i] != urlsB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// forceUpdate asks the server to download the lists now rather than at the
+// next update interval. The settings are already applied when it fails, so
+// the failure is only a warning.
+func (r *blockListsResource) forceUpdate(ctx context.Context, diags *diag.Diagnostics) {
+	answ, _, err := r.client.SettingsAPI.ForceUpdateBlockLists(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		diags.AddWarning(
+			"Error updating block lists",
+			"The block list URLs were saved and the server downloads the lists at the next update interval, "+
+				"but they could not be downloaded now, "+describeAPIError(err),
+		)
+	}
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockListsResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the lists are downloaded right away
+			{
+				Config: testAccProviderConfig(server) + `
+resource "technitium_block_lists" "test" {
+  block_list_urls = ["https://example.com/hosts.txt", "https://example.net/ads.txt"]
+  allow_list_urls = ["https://example.com/allow.txt"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_block_lists.test", "id", "block_lists"),
+					resource.TestCheckResourceAttr("technitium_block_lists.test", "block_list_urls.#", "2"),
+					resource.TestCheckResourceAttr("technitium_block_lists.test", "allow_list_urls.0", "https://example.com/allow.txt"),
+					resource.TestCheckNoResourceAttr("technitium_block_lists.test", "update_interval_hours"),
+					testAccCheckDnsSetting(server, "blockListUrls", []string{
+						"https://example.com/hosts.txt", "https://example.net/ads.txt", "!https://example.com/allow.txt",
+					}),
+					testAccCheckBlockListUpdates(server, 1),
+				),
+			},
+			// Changing only the update interval does not download the lists
+			{
+				Config: testAccProviderConfig(server) + `
+resource "technitium_block_lists" "test" {
+  block_list_urls       = ["https://example.com/hosts.txt", "https://example.net/ads.txt"]
+  allow_list_urls       = ["https://example.com/allow.txt"]
+  update_interval_hours = 12
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_block_lists.test", "update_interval_hours", "12"),
+					testAccCheckDnsSetting(server, "blockListUrlUpdateIntervalHours", 12),
+					testAccCheckBlockListUpdates(server, 1),
+				),
+			},
+			// Reordering the URLs does not download the lists
+			{
+				Config: testAccProviderConfig(server) + `
+resource "technitium_block_lists" "test" {
+  block_list_urls       = ["https://example.net/ads.txt", "https://example.com/hosts.txt"]
+  allow_list_urls       = ["https://example.com/allow.txt"]
+  update_interval_hours = 12
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDnsSetting(server, "blockListUrls", []string{
+						"https://example.net/ads.txt", "https://example.com/hosts.txt", "!https://example.com/allow.txt",
+					}),
+					testAccCheckBlockListUpdates(server, 1),
+				),
+			},
+			// Changing the URLs downloads the lists again
+			{
+				Config: testAccProviderConfig(server) + `
+resource "technitium_block_lists" "test" {
+  block_list_urls       = ["https://example.com/hosts.txt"]
+  update_interval_hours = 12
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_block_lists.test", "block_list_urls.#", "1"),
+					resource.TestCheckResourceAttr("technitium_block_lists.test", "allow_list_urls.#", "0"),
+					testAccCheckDnsSetting(server, "blockListUrls", []string{"https://example.com/hosts.txt"}),
+					testAccCheckBlockListUpdates(server, 2),
+				),
+			},
+			// A list added in the web console is detected and removed
+			testAccSettingsDriftStep(server, testAccProviderConfig(server)+`
+resource "technitium_block_lists" "test" {
+  block_list_urls       = ["https://example.com/hosts.txt"]
+  update_interval_hours = 12
+}
+`, []settingDrift{
+				{
+					key:     "blockListUrls",
+					drifted: []string{"https://example.com/hosts.txt", "!https://example.org/allow.txt"},
+					want:    []string{"https://example.com/hosts.txt"},
+				},
+			}, testAccCheckBlockListUpdates(server, 3)),
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBlockListsResource_invalidURL(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "technitium_block_lists" "test" {
+  allow_list_urls = ["!https://example.com/allow.txt"]
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must\s+be\s+an\s+http\s+or\s+https\s+URL`),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockListUpdates(server *fakeServer, expected int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		if server.blockListUpdates != expected {
+			return fmt.Errorf("block lists were downloaded %d times, expected %d", server.blockListUpdates, expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &blockingResource{}
+	_ resource.ResourceWithConfigure = &blockingResource{}
+)
+
+// NewBlockingResource is a helper function to simplify the provider implementation.
+func NewBlockingResource() resource.Resource {
+	r := &blockingResource{}
+	r.settingsResource = settingsResource[blockingResourceModel]{
+		id:      "blocking",
+		name:    "blocking settings",
+		set:     r.setBlocking,
+		refresh: refreshBlocking,
+		applied: r.disableTemporarily,
+	}
+	return r
+}
+
+// blockingResource manages whether and how the DNS server answers queries
+// for blocked domains. Setting temporary_disable_triggers, or changing them,
+// disables blocking for temporary_disable_minutes once, after which the
+// server enables it again on its own.
+type blockingResource struct {
+	settingsResource[blockingResourceModel]
+}
+
+// blockingResourceModel maps the resource schema data.
+type blockingResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	EnableBlocking           types.Bool   `tfsdk:"enable_blocking"`
+	BlockingType             types.String `tfsdk:"blocking_type"`
+	CustomBlockingAddresses  types.List   `tfsdk:"custom_blocking_addresses"`
+	BlockingAnswerTtl        types.Int32  `tfsdk:"blocking_answer_ttl"`
+	AllowTxtBlockingReport   types.Bool   `tfsdk:"allow_txt_blocking_report"`
+	BlockingBypassList       types.List   `tfsdk:"blocking_bypass_list"`
+	TemporaryDisableMinutes  types.Int32  `tfsdk:"temporary_disable_minutes"`
+	TemporaryDisableTriggers types.Map    `tfsdk:"temporary_disable_triggers"`
+}
+
+// Metadata returns the resource type name.
+func (r *blockingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blocking"
+}
+
+// Schema defines the schema for the resource.
+func (r *blockingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_blocking": settingBool(),
+			"blocking_type": settingString(
+				stringvalidator.OneOf("AnyAddress", "NxDomain", "CustomAddress"),
+			),
+			"custom_blocking_addresses": settingList(ipAddress()),
+			"blocking_answer_ttl":       settingInt32(int32validator.AtLeast(0)),
+			"allow_txt_blocking_report": settingBool(),
+			"blocking_bypass_list":      settingList(networkACLEntry()),
+			"temporary_disable_minutes": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AlsoRequires(path.MatchRoot("temporary_disable_triggers")),
+				},
+			},
+			"temporary_disable_triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.AlsoRequires(path.MatchRoot("temporary_disable_minutes")),
+				},
+			},
+		},
+	}
+}
+
+// setBlocking adds the declared blocking settings to a set settings request.
+// Enabling blocking ends a temporary disable, so blocking is left for the
+// server to enable again while it is disabled for a while.
+func (r *blockingResource) setBlocking(ctx context.Context, settings technitium.ApiSetSettingsRequest, plan blockingResourceModel, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+	if !plan.EnableBlocking.IsNull() && (!plan.EnableBlocking.ValueBool() || !r.temporarilyDisabled(ctx, diags)) {
+		settings = settings.EnableBlocking(plan.EnableBlocking.ValueBool())
+	}
+	if !plan.BlockingType.IsNull() {
+		settings = settings.BlockingType(plan.BlockingType.ValueString())
+	}
+	if !plan.CustomBlockingAddresses.IsNull() {
+		settings = settings.CustomBlockingAddresses(stringListElements(ctx, plan.CustomBlockingAddresses, diags))
+	}
+	if !plan.BlockingAnswerTtl.IsNull() {
+		settings = settings.BlockingAnswerTtl(plan.BlockingAnswerTtl.ValueInt32())
+	}
+	if !plan.AllowTxtBlockingReport.IsNull() {
+		settings = settings.AllowTxtBlockingReport(plan.AllowTxtBlockingReport.ValueBool())
+	}
+	if !plan.BlockingBypassList.IsNull() {
+		settings = settings.BlockingBypassList(stringListElements(ctx, plan.BlockingBypassList, diags))
+	}
+	return settings
+}
+
+// refreshBlocking copies the managed blocking settings from the server.
+// Blocking that is declared enabled and only disabled for a while is reported
+// as enabled, so that a maintenance window does not show up as drift.
+func refreshBlocking(ctx context.Context, model *blockingResourceModel, settings *technitium.DnsSettings, diags *diag.Diagnostics) {
+	enableBlocking := settings.EnableBlocking
+	if model.EnableBlocking.ValueBool() && settings.GetTemporaryDisableBlockingTill() != "" {
+		enabled := true
+		enableBlocking = &enabled
+	}
+	model.EnableBlocking = refreshBool(model.EnableBlocking, enableBlocking)
+	model.BlockingType = refreshString(model.BlockingType, settings.BlockingType)
+	model.CustomBlockingAddresses = refreshList(ctx, model.CustomBlockingAddresses, settings.CustomBlockingAddresses, diags)
+	model.BlockingAnswerTtl = refreshInt32(model.BlockingAnswerTtl, settings.BlockingAnswerTtl)
+	model.AllowTxtBlockingReport = refreshBool(model.AllowTxtBlockingReport, settings.AllowTxtBlockingReport)
+	model.BlockingBypassList = refreshList(ctx, model.BlockingBypassList, settings.BlockingBypassList, diags)
+}
+
+// temporarilyDisabled returns whether blocking is disabled for a while.
+func (r *blockingResource) temporarilyDisabled(ctx context.Context, diags *diag.Diagnostics) bool {
+	answ, _, err := r.client.SettingsAPI.GetSettings(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(diags, "read", "blocking settings", "", err)
+		return false
+	}
+	return answ.Response.GetTemporaryDisableBlockingTill() != ""
+}
+
+// disableTemporarily starts the maintenance window when the triggers are
+// declared or change.
+func (r *blockingResource) disableTemporarily(ctx context.Context, plan blockingResourceModel, state *blockingResourceModel, diags *diag.Diagnostics) {
+	if plan.TemporaryDisableTriggers.IsNull() || (state != nil && plan.TemporaryDisableTriggers.Equal(state.TemporaryDisableTriggers)) {
+		return
+	}
+	r.temporaryDisable(ctx, plan.TemporaryDisableMinutes.ValueInt32(), diags)
+}
+
+// temporaryDisable disables blocking for the given number of minutes.
+func (r *blockingResource) temporaryDisable(ctx context.Context, minutes int32, diags *diag.Diagnostics) {
+	answ, _, err := r.client.SettingsAPI.TemporaryDisableBlocking(ctx).Minutes(minutes).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(diags, "disable", "blocking", "", err)
+		return
+	}
+	tflog.Info(ctx, "Disabled blocking temporarily", map[string]interface{}{
+		"till": answ.Response.GetTemporaryDisableBlockingTill(),
+	})
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockingResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccBlockingResourceConfig(`
+  enable_blocking           = true
+  blocking_type             = "CustomAddress"
+  custom_blocking_addresses = ["192.0.2.1", "2001:db8::1"]
+  blocking_answer_ttl       = 60
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_blocking.test", "id", "blocking"),
+					resource.TestCheckResourceAttr("technitium_blocking.test", "blocking_type", "CustomAddress"),
+					resource.TestCheckResourceAttr("technitium_blocking.test", "custom_blocking_addresses.#", "2"),
+					resource.TestCheckNoResourceAttr("technitium_blocking.test", "temporary_disable_minutes"),
+					testAccCheckDnsSetting(server, "blockingType", "CustomAddress"),
+					testAccCheckDnsSetting(server, "customBlockingAddresses", []string{"192.0.2.1", "2001:db8::1"}),
+					testAccCheckDnsSetting(server, "blockingAnswerTtl", 60),
+				),
+			},
+			// Blocking disabled outside of Terraform is detected and enabled again
+			testAccSettingsDriftStep(server, testAccProviderConfig(server)+testAccBlockingResourceConfig(`
+  enable_blocking           = true
+  blocking_type             = "CustomAddress"
+  custom_blocking_addresses = ["192.0.2.1", "2001:db8::1"]
+  blocking_answer_ttl       = 60
+`), []settingDrift{
+				{key: "enableBlocking", drifted: false, want: true},
+			}),
+			// Setting the triggers disables blocking for a while, without
+			// showing up as drift
+			{
+				Config: testAccProviderConfig(server) + testAccBlockingResourceConfig(`
+  enable_blocking            = true
+  blocking_type              = "NxDomain"
+  temporary_disable_minutes  = 15
+  temporary_disable_triggers = { window = "1" }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_blocking.test", "enable_blocking", "true"),
+					resource.TestCheckResourceAttr("technitium_blocking.test", "temporary_disable_minutes", "15"),
+					testAccCheckDnsSetting(server, "blockingType", "NxDomain"),
+					testAccCheckDnsSetting(server, "enableBlocking", false),
+					testAccCheckBlockingTemporarilyDisabled(server, true),
+				),
+			},
+			// Other changes during the window leave blocking disabled
+			{
+				Config: testAccProviderConfig(server) + testAccBlockingResourceConfig(`
+  enable_blocking            = true
+  blocking_type              = "AnyAddress"
+  temporary_disable_minutes  = 15
+  temporary_disable_triggers = { window = "1" }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDnsSetting(server, "blockingType", "AnyAddress"),
+					testAccCheckDnsSetting(server, "enableBlocking", false),
+					testAccCheckBlockingTemporarilyDisabled(server, true),
+				),
+			},
+			// The window is not opened again while the triggers are unchanged
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.settings["enableBlocking"] = true
+					delete(server.settings, "temporaryDisableBlockingTill")
+				},
+				Config: testAccProviderConfig(server) + testAccBlockingResourceConfig(`
+  enable_blocking            = true
+  blocking_type              = "AnyAddress"
+  temporary_disable_minutes  = 30
+  temporary_disable_triggers = { window = "1" }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDnsSetting(server, "enableBlocking", true),
+					testAccCheckBlockingTemporarilyDisabled(server, false),
+				),
+			},
+			// Changing the triggers opens it again
+			{
+				Config: testAccProviderConfig(server) + testAccBlockingResourceConfig(`
+  enable_blocking            = true
+  blocking_type              = "AnyAddress"
+  temporary_disable_minutes  = 30
+  temporary_disable_triggers = { window = "2" }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDnsSetting(server, "enableBlocking", false),
+					testAccCheckBlockingTemporarilyDisabled(server, true),
+				),
+			},
+			// Disabling blocking
+			{
+				Config: testAccProviderConfig(server) + testAccBlockingResourceConfig(`
+  enable_blocking = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_blocking.test", "enable_blocking", "false"),
+					resource.TestCheckNoResourceAttr("technitium_blocking.test", "blocking_type"),
+					testAccCheckDnsSetting(server, "enableBlocking", false),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBlockingResource_invalidAddress(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccBlockingResourceConfig(`
+  blocking_type             = "CustomAddress"
+  custom_blocking_addresses = ["blocked.example.com"]
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid\s+IP\s+Address`),
+			},
+		},
+	})
+}
+
+func TestAccBlockingResource_temporaryDisableWithoutTriggers(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccBlockingResourceConfig(`
+  temporary_disable_minutes = 15
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute\s+"temporary_disable_triggers"\s+must\s+be\s+specified`),
+			},
+		},
+	})
+}
+
+func testAccBlockingResourceConfig(settings string) string {
+	return fmt.Sprintf(`
+resource "technitium_blocking" "test" {%s}
+`, settings)
+}
+
+func testAccCheckBlockingTemporarilyDisabled(server *fakeServer, expected bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		if _, disabled := server.settings["temporaryDisableBlockingTill"]; disabled != expected {
+			return fmt.Errorf("blocking temporarily disabled is %t, expected %t", disabled, expected)
+		}
+		return nil
+	}
+}
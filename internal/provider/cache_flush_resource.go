@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &cacheFlushResource{}
+	_ resource.ResourceWithConfigure = &cacheFlushResource{}
+)
+
+// NewCacheFlushResource is a helper function to simplify the provider implementation.
+func NewCacheFlushResource() resource.Resource {
+	return &cacheFlushResource{}
+}
+
+// cacheFlushResource flushes the DNS cache, or a single domain from it, when
+// it is created. Changing the domain or the triggers replaces the resource,
+// which flushes the cache again.
+type cacheFlushResource struct {
+	client *technitiumClient
+}
+
+// cacheFlushResourceModel maps the resource schema data.
+type cacheFlushResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Domain   types.String `tfsdk:"domain"`
+	Triggers types.Map    `tfsdk:"triggers"`
+}
+
+// Metadata returns the resource type name.
+func (r *cacheFlushResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cache_flush"
+}
+
+// Schema defines the schema for the resource.
+func (r *cacheFlushResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create flushes the cache and sets the initial Terraform state.
+func (r *cacheFlushResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan cacheFlushResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Flush the whole cache, or only the domain
+	if plan.Domain.IsNull() {
+		answ, _, err := r.client.CacheAPI.FlushCache(ctx).Execute()
+		if err = checkResponse(answ, err); err != nil {
+			addAPIError(&resp.Diagnostics, "flush", "cache", "", err)
+			return
+		}
+		plan.ID = types.StringValue(".")
+	} else {
+		answ, _, err := r.client.CacheAPI.DeleteCachedZone(ctx).Domain(plan.Domain.ValueString()).Execute()
+		if err = checkResponse(answ, err); err != nil {
+			addAPIError(&resp.Diagnostics, "flush", "cached domain", plan.Domain.ValueString(), err)
+			return
+		}
+		plan.ID = plan.Domain
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read keeps the Terraform state, a flush leaves nothing to refresh.
+func (r *cacheFlushResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// Update is never called with changes, since every attribute replaces the
+// resource, but keeps the state in line with the plan.
+func (r *cacheFlushResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan cacheFlushResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the Terraform state.
+func (r *cacheFlushResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *cacheFlushResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccCacheFlushResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Creating the resource flushes the cache
+			{
+				Config: testAccProviderConfig(server) + testAccCacheFlushResourceConfig("", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_cache_flush.test", "id", "."),
+					testAccCheckCacheFlushes(server, "."),
+				),
+			},
+			// Nothing is flushed while the configuration is unchanged
+			{
+				Config: testAccProviderConfig(server) + testAccCacheFlushResourceConfig("", "1"),
+				Check:  testAccCheckCacheFlushes(server, "."),
+			},
+			// Changing the triggers flushes again
+			{
+				Config: testAccProviderConfig(server) + testAccCacheFlushResourceConfig("", "2"),
+				Check:  testAccCheckCacheFlushes(server, ".", "."),
+			},
+			// Setting a domain flushes only that domain
+			{
+				Config: testAccProviderConfig(server) + testAccCacheFlushResourceConfig("example.com", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_cache_flush.test", "id", "example.com"),
+					testAccCheckCacheFlushes(server, ".", ".", "example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCacheFlushResourceConfig(domain string, trigger string) string {
+	options := fmt.Sprintf(`triggers = { zone_serial = %q }`, trigger)
+	if domain != "" {
+		options += fmt.Sprintf("\n  domain = %q", domain)
+	}
+	return fmt.Sprintf(`
+resource "technitium_cache_flush" "test" {
+  %s
+}
+`, options)
+}
+
+func testAccCheckCacheFlushes(server *fakeServer, expected ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		if !reflect.DeepEqual(server.flushes, expected) {
+			return fmt.Errorf("cache flushes are %q, expected %q", server.flushes, expected)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &cacheSettingsResource{}
+	_ resource.ResourceWithConfigure      = &cacheSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &cacheSettingsResource{}
+)
+
+// NewCacheSettingsResource is a helper function to simplify the provider implementation.
+func NewCacheSettingsResource() resource.Resource {
+	return &cacheSettingsResource{
+		settingsResource: settingsResource[cacheSettingsResourceModel]{
+			id:      "cache_settings",
+			name:    "cache settings",
+			set:     setCacheSettings,
+			refresh: refreshCacheSettings,
+		},
+	}
+}
+
+// cacheSettingsResource manages how the DNS server caches answers: serving
+// stale records, prefetching, record TTL bounds and the cache size.
+type cacheSettingsResource struct {
+	settingsResource[cacheSettingsResourceModel]
+}
+
+// cacheSettingsResourceModel maps the resource schema data.
+type cacheSettingsResourceModel struct {
+	ID                                        types.String `tfsdk:"id"`
+	SaveCache                                 types.Bool   `tfsdk:"save_cache"`
+	ServeStale                                types.Bool   `tfsdk:"serve_stale"`
+	ServeStaleTtl                             types.Int32  `tfsdk:"serve_stale_ttl"`
+	ServeStaleAnswerTtl                       types.Int32  `tfsdk:"serve_stale_answer_ttl"`
+	ServeStaleResetTtl                        types.Int32  `tfsdk:"serve_stale_reset_ttl"`
+	ServeStaleMaxWaitTime                     types.Int32  `tfsdk:"serve_stale_max_wait_time"`
+	CacheMaximumEntries                       types.Int64  `tfsdk:"cache_maximum_entries"`
+	CacheMinimumRecordTtl                     types.Int32  `tfsdk:"cache_minimum_record_ttl"`
+	CacheMaximumRecordTtl                     types.Int32  `tfsdk:"cache_maximum_record_ttl"`
+	CacheNegativeRecordTtl                    types.Int32  `tfsdk:"cache_negative_record_ttl"`
+	CacheFailureRecordTtl                     types.Int32  `tfsdk:"cache_failure_record_ttl"`
+	CachePrefetchEligibility                  types.Int32  `tfsdk:"cache_prefetch_eligibility"`
+	CachePrefetchTrigger                      types.Int32  `tfsdk:"cache_prefetch_trigger"`
+	CachePrefetchSampleIntervalInMinutes      types.Int32  `tfsdk:"cache_prefetch_sample_interval_in_minutes"`
+	CachePrefetchSampleEligibilityHitsPerHour types.Int32  `tfsdk:"cache_prefetch_sample_eligibility_hits_per_hour"`
+}
+
+// Metadata returns the resource type name.
+func (r *cacheSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cache_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *cacheSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"save_cache":                                      settingBool(),
+			"serve_stale":                                     settingBool(),
+			"serve_stale_ttl":                                 settingInt32(int32validator.Between(0, 604800)),
+			"serve_stale_answer_ttl":                          settingInt32(int32validator.Between(0, 300)),
+			"serve_stale_reset_ttl":                           settingInt32(int32validator.Between(10, 900)),
+			"serve_stale_max_wait_time":                       settingInt32(int32validator.Between(0, 1800)),
+			"cache_maximum_entries":                           settingInt64(int64validator.AtLeast(0)),
+			"cache_minimum_record_ttl":                        settingInt32(int32validator.AtLeast(0)),
+			"cache_maximum_record_ttl":                        settingInt32(int32validator.AtLeast(0)),
+			"cache_negative_record_ttl":                       settingInt32(int32validator.AtLeast(0)),
+			"cache_failure_record_ttl":                        settingInt32(int32validator.AtLeast(0)),
+			"cache_prefetch_eligibility":                      settingInt32(int32validator.AtLeast(0)),
+			"cache_prefetch_trigger":                          settingInt32(int32validator.AtLeast(0)),
+			"cache_prefetch_sample_interval_in_minutes":       settingInt32(int32validator.Between(1, 60)),
+			"cache_prefetch_sample_eligibility_hits_per_hour": settingInt32(int32validator.AtLeast(0)),
+		},
+	}
+}
+
+// ValidateConfig checks that the minimum record TTL does not exceed the
+// maximum record TTL.
+func (r *cacheSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config cacheSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	minimum, maximum := config.CacheMinimumRecordTtl, config.CacheMaximumRecordTtl
+	if minimum.IsNull() || minimum.IsUnknown() || maximum.IsNull() || maximum.IsUnknown() {
+		return
+	}
+	if minimum.ValueInt32() > maximum.ValueInt32() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cache_minimum_record_ttl"),
+			"Invalid Cache Record TTL",
+			fmt.Sprintf("The minimum record TTL %d is greater than the maximum record TTL %d.", minimum.ValueInt32(), maximum.ValueInt32()),
+		)
+	}
+}
+
+// setCacheSettings adds the declared cache settings to a set settings request.
+func setCacheSettings(ctx context.Context, settings technitium.ApiSetSettingsRequest, plan cacheSettingsResourceModel, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+	if !plan.SaveCache.IsNull() {
+		settings = settings.SaveCache(plan.SaveCache.ValueBool())
+	}
+	if !plan.ServeStale.IsNull() {
+		settings = settings.ServeStale(plan.ServeStale.ValueBool())
+	}
+	if !plan.ServeStaleTtl.IsNull() {
+		settings = settings.ServeStaleTtl(plan.ServeStaleTtl.ValueInt32())
+	}
+	if !plan.ServeStaleAnswerTtl.IsNull() {
+		settings = settings.ServeStaleAnswerTtl(plan.ServeStaleAnswerTtl.ValueInt32())
+	}
+	if !plan.ServeStaleResetTtl.IsNull() {
+		settings = settings.ServeStaleResetTtl(plan.ServeStaleResetTtl.ValueInt32())
+	}
+	if !plan.ServeStaleMaxWaitTime.IsNull() {
+		settings = settings.ServeStaleMaxWaitTime(plan.ServeStaleMaxWaitTime.ValueInt32())
+	}
+	if !plan.CacheMaximumEntries.IsNull() {
+		settings = settings.CacheMaximumEntries(plan.CacheMaximumEntries.ValueInt64())
+	}
+	if !plan.CacheMinimumRecordTtl.IsNull() {
+		settings = settings.CacheMinimumRecordTtl(plan.CacheMinimumRecordTtl.ValueInt32())
+	}
+	if !plan.CacheMaximumRecordTtl.IsNull() {
+		settings = settings.CacheMaximumRecordTtl(plan.CacheMaximumRecordTtl.ValueInt32())
+	}
+	if !plan.CacheNegativeRecordTtl.IsNull() {
+		settings = settings.CacheNegativeRecordTtl(plan.CacheNegativeRecordTtl.ValueInt32())
+	}
+	if !plan.CacheFailureRecordTtl.IsNull() {
+		settings = settings.CacheFailureRecordTtl(plan.CacheFailureRecordTtl.ValueInt32())
+	}
+	if !plan.CachePrefetchEligibility.IsNull() {
+		settings = settings.CachePrefetchEligibility(plan.CachePrefetchEligibility.ValueInt32())
+	}
+	if !plan.CachePrefetchTrigger.IsNull() {
+		settings = settings.CachePrefetchTrigger(plan.CachePrefetchTrigger.ValueInt32())
+	}
+	if !plan.CachePrefetchSampleIntervalInMinutes.IsNull() {
+		settings = settings.CachePrefetchSampleIntervalInMinutes(plan.CachePrefetchSampleIntervalInMinutes.ValueInt32())
+	}
+	if !plan.CachePrefetchSampleEligibilityHitsPerHour.IsNull() {
+		settings = settings.CachePrefetchSampleEligibilityHitsPerHour(plan.CachePrefetchSampleEligibilityHitsPerHour.ValueInt32())
+	}
+	return settings
+}
+
+// refreshCacheSettings copies the managed cache settings from the server.
+func refreshCacheSettings(ctx context.Context, model *cacheSettingsResourceModel, settings *technitium.DnsSettings, diags *diag.Diagnostics) {
+	model.SaveCache = refreshBool(model.SaveCache, settings.SaveCache)
+	model.ServeStale = refreshBool(model.ServeStale, settings.ServeStale)
+	model.ServeStaleTtl = refreshInt32(model.ServeStaleTtl, settings.ServeStaleTtl)
+	model.ServeStaleAnswerTtl = refreshInt32(model.ServeStaleAnswerTtl, settings.ServeStaleAnswerTtl)
+	model.ServeStaleResetTtl = refreshInt32(model.ServeStaleResetTtl, settings.ServeStaleResetTtl)
+	model.ServeStaleMaxWaitTime = refreshInt32(model.ServeStaleMaxWaitTime, settings.ServeStaleMaxWaitTime)
+	model.CacheMaximumEntries = refreshInt64(model.CacheMaximumEntries, settings.CacheMaximumEntries)
+	model.CacheMinimumRecordTtl = refreshInt32(model.CacheMinimumRecordTtl, settings.CacheMinimumRecordTtl)
+	model.CacheMaximumRecordTtl = refreshInt32(model.CacheMaximumRecordTtl, settings.CacheMaximumRecordTtl)
+	model.CacheNegativeRecordTtl = refreshInt32(model.CacheNegativeRecordTtl, settings.CacheNegativeRecordTtl)
+	model.CacheFailureRecordTtl = refreshInt32(model.CacheFailureRecordTtl, settings.CacheFailureRecordTtl)
+	model.CachePrefetchEligibility = refreshInt32(model.CachePrefetchEligibility, settings.CachePrefetchEligibility)
+	model.CachePrefetchTrigger = refreshInt32(model.CachePrefetchTrigger, settings.CachePrefetchTrigger)
+	model.CachePrefetchSampleIntervalInMinutes = refreshInt32(model.CachePrefetchSampleIntervalInMinutes, settings.CachePrefetchSampleIntervalInMinutes)
+	model.CachePrefetchSampleEligibilityHitsPerHour = refreshInt32(model.CachePrefetchSampleEligibilityHitsPerHour, settings.CachePrefetchSampleEligibilityHitsPerHour)
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCacheSettingsResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccCacheSettingsResourceConfig(`
+  serve_stale              = false
+  cache_maximum_entries    = 5000000000
+  cache_minimum_record_ttl = 60
+  cache_maximum_record_ttl = 86400
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_cache_settings.test", "id", "cache_settings"),
+					resource.TestCheckResourceAttr("technitium_cache_settings.test", "serve_stale", "false"),
+					resource.TestCheckResourceAttr("technitium_cache_settings.test", "cache_maximum_entries", "5000000000"),
+					resource.TestCheckNoResourceAttr("technitium_cache_settings.test", "cache_prefetch_trigger"),
+					testAccCheckDnsSetting(server, "serveStale", false),
+					testAccCheckDnsSetting(server, "cacheMaximumEntries", 5000000000),
+					testAccCheckDnsSetting(server, "cacheMinimumRecordTtl", 60),
+					testAccCheckDnsSetting(server, "cacheMaximumRecordTtl", 86400),
+					testAccCheckDnsSetting(server, "cachePrefetchTrigger", 9),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccCacheSettingsResourceConfig(`
+  serve_stale                = true
+  serve_stale_ttl            = 3600
+  cache_negative_record_ttl  = 60
+  cache_prefetch_eligibility = 5
+  cache_prefetch_trigger     = 0
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_cache_settings.test", "serve_stale_ttl", "3600"),
+					resource.TestCheckResourceAttr("technitium_cache_settings.test", "cache_prefetch_trigger", "0"),
+					resource.TestCheckNoResourceAttr("technitium_cache_settings.test", "cache_maximum_entries"),
+					testAccCheckDnsSetting(server, "serveStale", true),
+					testAccCheckDnsSetting(server, "serveStaleTtl", 3600),
+					testAccCheckDnsSetting(server, "cacheNegativeRecordTtl", 60),
+					testAccCheckDnsSetting(server, "cachePrefetchEligibility", 5),
+					testAccCheckDnsSetting(server, "cachePrefetchTrigger", 0),
+					testAccCheckDnsSetting(server, "cacheMaximumEntries", 5000000000),
+				),
+			},
+			// A setting changed outside of Terraform is detected and reverted
+			testAccSettingsDriftStep(server, testAccProviderConfig(server)+testAccCacheSettingsResourceConfig(`
+  serve_stale                = true
+  serve_stale_ttl            = 3600
+  cache_negative_record_ttl  = 60
+  cache_prefetch_eligibility = 5
+  cache_prefetch_trigger     = 0
+`), []settingDrift{
+				{key: "serveStaleTtl", drifted: 60, want: 3600},
+			}),
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCacheSettingsResource_invalidRecordTtl(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccCacheSettingsResourceConfig(`
+  cache_minimum_record_ttl = 3600
+  cache_maximum_record_ttl = 60
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The\s+minimum\s+record\s+TTL\s+3600\s+is\s+greater\s+than\s+the\s+maximum\s+record\s+TTL\s+60`),
+			},
+		},
+	})
+}
+
+func testAccCacheSettingsResourceConfig(settings string) string {
+	return fmt.Sprintf(`
+resource "technitium_cache_settings" "test" {%s}
+`, settings)
+}
@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &clusterNodeResource{}
+	_ resource.ResourceWithConfigure  = &clusterNodeResource{}
+	_ resource.ResourceWithModifyPlan = &clusterNodeResource{}
+)
+
+// NewClusterNodeResource is a helper function to simplify the provider implementation.
+func NewClusterNodeResource() resource.Resource {
+	return &clusterNodeResource{}
+}
+
+// clusterNodeResource joins the configured server to a cluster as a
+// secondary node. Destroying it leaves the cluster, removing the node on the
+// primary node when it cannot leave by itself.
+type clusterNodeResource struct {
+	client *technitiumClient
+}
+
+// clusterNodeResourceModel maps the resource schema data.
+type clusterNodeResourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	PrimaryNodeURL           types.String `tfsdk:"primary_node_url"`
+	PrimaryNodeIPAddress     types.String `tfsdk:"primary_node_ip_address"`
+	PrimaryNodeUsername      types.String `tfsdk:"primary_node_username"`
+	PrimaryNodePassword      types.String `tfsdk:"primary_node_password"`
+	PrimaryNodeTotp          types.String `tfsdk:"primary_node_totp"`
+	SecondaryNodeIPAddresses types.List   `tfsdk:"secondary_node_ip_addresses"`
+	IgnoreCertificateErrors  types.Bool   `tfsdk:"ignore_certificate_errors"`
+	ForceLeave               types.Bool   `tfsdk:"force_leave"`
+	ClusterDomain            types.String `tfsdk:"cluster_domain"`
+	NodeName                 types.String `tfsdk:"node_name"`
+}
+
+// Metadata returns the resource type name.
+func (r *clusterNodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_node"
+}
+
+// Schema defines the schema for the resource.
+func (r *clusterNodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"primary_node_url": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_node_ip_address": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			// The credentials are only used to join the cluster and to remove
+			// the node on the primary node when it cannot leave by itself.
+			"primary_node_username": schema.StringAttribute{
+				Required: true,
+			},
+			"primary_node_password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"primary_node_totp": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"secondary_node_ip_addresses": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"ignore_certificate_errors": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"force_leave": schema.BoolAttribute{
+				Optional: true,
+			},
+			"cluster_domain": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects clusters on servers older than version 14.
+func (r *clusterNodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the node leaves or the provider is not
+	// configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	r.client.requireFeature(&resp.Diagnostics, path.Root("primary_node_url"), featureClustering)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *clusterNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan clusterNodeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var addresses []string
+	resp.Diagnostics.Append(plan.SecondaryNodeIPAddresses.ElementsAs(ctx, &addresses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Join the cluster of the primary node
+	join := r.client.ClusterAPI.JoinCluster(ctx)
+	join = join.PrimaryNodeUrl(plan.PrimaryNodeURL.ValueString())
+	join = join.PrimaryNodeUsername(plan.PrimaryNodeUsername.ValueString())
+	join = join.PrimaryNodePassword(plan.PrimaryNodePassword.ValueString())
+	join = join.SecondaryNodeIpAddresses(addresses)
+	if !plan.PrimaryNodeIPAddress.IsNull() {
+		join = join.PrimaryNodeIpAddress(plan.PrimaryNodeIPAddress.ValueString())
+	}
+	if !plan.PrimaryNodeTotp.IsNull() {
+		join = join.PrimaryNodeTotp(plan.PrimaryNodeTotp.ValueString())
+	}
+	if !plan.IgnoreCertificateErrors.IsNull() {
+		join = join.IgnoreCertificateErrors(plan.IgnoreCertificateErrors.ValueBool())
+	}
+	answ, _, err := join.Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "join", "cluster", "at "+plan.PrimaryNodeURL.ValueString(), err)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	self := clusterSelf(answ.Response)
+	if self == nil {
+		resp.Diagnostics.AddError(
+			"Error joining cluster",
+			"Could not join cluster at "+plan.PrimaryNodeURL.ValueString()+", the server did not list itself as a cluster node after joining.",
+		)
+		return
+	}
+	plan.ID = types.StringValue(strconv.Itoa(int(self.GetId())))
+	plan.ClusterDomain = types.StringValue(answ.Response.GetClusterDomain())
+	plan.NodeName = types.StringValue(self.GetName())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clusterNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state clusterNodeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed cluster state from Technitium
+	answ, _, err := r.client.ClusterAPI.GetClusterState(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "cluster node", state.NodeName.ValueString(), err)
+		return
+	}
+
+	// The server left the cluster outside of Terraform.
+	self := clusterSelf(answ.Response)
+	if !answ.Response.GetClusterInitialized() || self == nil || self.GetType() != "Secondary" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(strconv.Itoa(int(self.GetId())))
+	state.ClusterDomain = types.StringValue(answ.Response.GetClusterDomain())
+	state.NodeName = types.StringValue(self.GetName())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *clusterNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only attributes used when joining or leaving can change, so there is
+	// nothing to send to the server.
+	var plan clusterNodeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *clusterNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state clusterNodeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Leave the cluster
+	leave := r.client.ClusterAPI.LeaveCluster(ctx)
+	if !state.ForceLeave.IsNull() {
+		leave = leave.ForceLeave(state.ForceLeave.ValueBool())
+	}
+	answ, _, err := leave.Execute()
+	err = checkResponse(answ, err)
+	var apiErr *APIError
+	unreachable := err != nil && !errors.As(err, &apiErr) && !errors.Is(err, ErrInvalidToken)
+	if err != nil && !unreachable {
+		addAPIError(&resp.Diagnostics, "leave", "cluster", state.ClusterDomain.ValueString(), err)
+		return
+	}
+
+	// A node that cannot be reached, or that was forced out without
+	// telling the primary node, is removed on the primary node instead.
+	if !unreachable && !state.ForceLeave.ValueBool() {
+		return
+	}
+	removeErr := removeFromPrimary(ctx, state)
+	switch {
+	case removeErr == nil:
+	case unreachable:
+		addAPIError(&resp.Diagnostics, "leave", "cluster", state.ClusterDomain.ValueString(), err)
+		addAPIError(&resp.Diagnostics, "remove", "cluster node", state.NodeName.ValueString()+" on the primary node", removeErr)
+	default:
+		resp.Diagnostics.AddWarning(
+			"Cluster node not removed on the primary node",
+			"The node left the cluster, but could not be removed on the primary node "+state.PrimaryNodeURL.ValueString()+", "+
+				describeAPIError(removeErr)+" Remove the node on the primary node to stop it from waiting for the node.",
+		)
+	}
+}
+
+// removeFromPrimary removes the node on the primary node, logging in with
+// the credentials the node joined with. Nothing is done when the primary
+// node no longer lists the node.
+func removeFromPrimary(ctx context.Context, state clusterNodeResourceModel) error {
+	id, err := strconv.ParseInt(state.ID.ValueString(), 10, 32)
+	if err != nil {
+		return fmt.Errorf("invalid cluster node id %q", state.ID.ValueString())
+	}
+
+	client, err := newPrimaryNodeClient(ctx, state)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _, _ = client.UserAPI.Logout(ctx).Execute()
+	}()
+
+	answ, _, err := client.ClusterAPI.GetClusterState(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		return err
+	}
+	listed := false
+	for _, node := range answ.Response.ClusterNodes {
+		listed = listed || (node.GetId() == int32(id) && node.GetType() == "Secondary")
+	}
+	if !listed {
+		return nil
+	}
+
+	removed, _, err := client.ClusterAPI.RemoveSecondaryNode(ctx).SecondaryNodeId(int32(id)).Execute()
+	return checkResponse(removed, err)
+}
+
+// newPrimaryNodeClient returns a client logged in to the primary node of the
+// cluster the node joined.
+func newPrimaryNodeClient(ctx context.Context, state clusterNodeResourceModel) (*technitium.APIClient, error) {
+	tlsConfig, err := newTLSConfig(tlsSettings{
+		InsecureSkipVerify: state.IgnoreCertificateErrors.ValueBool(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	customClient := &CustomHTTPClient{
+		client: &http.Client{
+			Transport: newTransport(tlsConfig),
+		},
+	}
+	logging := &loggingTransport{
+		next: customClient,
+		secrets: func() []string {
+			return []string{customClient.currentToken(), state.PrimaryNodePassword.ValueString(), state.PrimaryNodeTotp.ValueString()}
+		},
+	}
+	cfg := technitium.NewConfiguration()
+	cfg.Servers = technitium.ServerConfigurations{
+		{
+			URL: state.PrimaryNodeURL.ValueString(),
+		},
+	}
+	cfg.HTTPClient = &http.Client{
+		Transport: &timeoutTransport{
+			next:    logging,
+			timeout: defaultRequestTimeout,
+		},
+	}
+	client := technitium.NewAPIClient(cfg)
+
+	token, err := login(ctx, client, state.PrimaryNodeUsername.ValueString(), state.PrimaryNodePassword.ValueString(), state.PrimaryNodeTotp.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	customClient.setToken(token)
+	return client, nil
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clusterNodeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
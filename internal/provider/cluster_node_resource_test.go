@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccClusterNodeResource(t *testing.T) {
+	primary, secondary := testAccClusterNodeServers(t)
+	config := testAccProviderConfig(secondary) + testAccClusterNodeResourceConfig(primary)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckClusterDestroy(secondary),
+			testAccCheckClusterNodes(primary, 1),
+		),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_cluster_node.test", "id", "2"),
+					resource.TestCheckResourceAttr("technitium_cluster_node.test", "cluster_domain", "cluster.fake"),
+					resource.TestCheckResourceAttr("technitium_cluster_node.test", "node_name", "dns2.fake"),
+					testAccCheckClusterNodes(primary, 2),
+				),
+			},
+			// A node that left the cluster outside of Terraform joins again
+			{
+				PreConfig: func() {
+					secondary.mu.Lock()
+					defer secondary.mu.Unlock()
+					secondary.cluster = nil
+
+					primary.mu.Lock()
+					defer primary.mu.Unlock()
+					primary.cluster.nodes = primary.cluster.nodes[:1]
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_cluster_node.test", "id", "3"),
+					testAccCheckClusterNodes(primary, 2),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccClusterNodeResource_unreachable(t *testing.T) {
+	primary, secondary := testAccClusterNodeServers(t)
+	config := testAccProviderConfig(secondary) + testAccClusterNodeResourceConfig(primary)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The node is removed on the primary node when it cannot be
+		// reached to leave the cluster.
+		CheckDestroy: testAccCheckClusterNodes(primary, 1),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCheckClusterNodes(primary, 2),
+			},
+			{
+				PreConfig: func() {
+					secondary.mu.Lock()
+					defer secondary.mu.Unlock()
+					secondary.dropped = map[string]bool{"/api/admin/cluster/secondary/leave": true}
+				},
+				Config: config,
+			},
+		},
+	})
+}
+
+func TestAccClusterNodeResource_forceLeave(t *testing.T) {
+	primary, secondary := testAccClusterNodeServers(t)
+	config := testAccProviderConfig(secondary) + fmt.Sprintf(`
+resource "technitium_cluster_node" "test" {
+  primary_node_url            = %q
+  primary_node_username       = %q
+  primary_node_password       = %q
+  secondary_node_ip_addresses = ["192.0.2.2"]
+  force_leave                 = true
+}
+`, primary.URL+"/", fakeUsername, fakePassword)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// A forced leave does not tell the primary node, so the node is
+		// removed there by the provider.
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckClusterDestroy(secondary),
+			testAccCheckClusterNodes(primary, 1),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  testAccCheckClusterNodes(primary, 2),
+			},
+		},
+	})
+}
+
+// testAccClusterNodeServers returns a primary node with an initialized
+// cluster and a secondary node to join it. Terraform shares one provider
+// instance between aliases in acceptance tests, so the cluster is
+// initialized on the primary node directly.
+func testAccClusterNodeServers(t *testing.T) (*fakeServer, *fakeServer) {
+	t.Helper()
+
+	primary := newFakeClusterServer(t, "dns.fake")
+	if _, err := primary.initCluster(url.Values{
+		"clusterDomain":          {"cluster.fake"},
+		"primaryNodeIpAddresses": {"192.0.2.1"},
+	}, fakeUsername); err != nil {
+		t.Fatal(err)
+	}
+	return primary, newFakeClusterServer(t, "dns2.fake")
+}
+
+func testAccClusterNodeResourceConfig(primary *fakeServer) string {
+	return fmt.Sprintf(`
+resource "technitium_cluster_node" "test" {
+  primary_node_url            = %q
+  primary_node_username       = %q
+  primary_node_password       = %q
+  secondary_node_ip_addresses = ["192.0.2.2"]
+}
+`, primary.URL+"/", fakeUsername, fakePassword)
+}
+
+// testAccCheckClusterNodes checks the number of nodes registered with the
+// primary node.
+func testAccCheckClusterNodes(primary *fakeServer, count int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		primary.mu.Lock()
+		defer primary.mu.Unlock()
+		if primary.cluster == nil {
+			return fmt.Errorf("cluster does not exist on the primary node")
+		}
+		if len(primary.cluster.nodes) != count {
+			return fmt.Errorf("cluster has %d nodes on the primary node, expected %d", len(primary.cluster.nodes), count)
+		}
+		return nil
+	}
+}
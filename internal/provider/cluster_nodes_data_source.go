@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clusterNodesDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterNodesDataSource{}
+)
+
+// clusterNodesDataSourceModel maps the data source schema data.
+type clusterNodesDataSourceModel struct {
+	ClusterInitialized types.Bool              `tfsdk:"cluster_initialized"`
+	ClusterDomain      types.String            `tfsdk:"cluster_domain"`
+	ConfigLastSynced   types.String            `tfsdk:"config_last_synced"`
+	Nodes              []clusterNodeStateModel `tfsdk:"nodes"`
+}
+
+// clusterNodeStateModel maps the cluster node schema data.
+type clusterNodeStateModel struct {
+	ID          types.Int32  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	URL         types.String `tfsdk:"url"`
+	IPAddresses types.List   `tfsdk:"ip_addresses"`
+	Type        types.String `tfsdk:"type"`
+	State       types.String `tfsdk:"state"`
+	UpSince     types.String `tfsdk:"up_since"`
+	LastSeen    types.String `tfsdk:"last_seen"`
+}
+
+type clusterNodesDataSource struct {
+	client *technitiumClient
+}
+
+func NewClusterNodesDataSource() datasource.DataSource {
+	return &clusterNodesDataSource{}
+}
+
+func (d *clusterNodesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_nodes"
+}
+
+// Schema defines the schema for the data source.
+func (d *clusterNodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_initialized": schema.BoolAttribute{
+				Computed: true,
+			},
+			"cluster_domain": schema.StringAttribute{
+				Computed: true,
+			},
+			"config_last_synced": schema.StringAttribute{
+				Computed: true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int32Attribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"url": schema.StringAttribute{
+							Computed: true,
+						},
+						"ip_addresses": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"state": schema.StringAttribute{
+							Computed: true,
+						},
+						"up_since": schema.StringAttribute{
+							Computed: true,
+						},
+						"last_seen": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *clusterNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	d.client.requireFeature(&resp.Diagnostics, path.Root("nodes"), featureClustering)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	answ, _, err := d.client.ClusterAPI.GetClusterState(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "cluster nodes", "", err)
+		return
+	}
+	cluster := answ.Response
+
+	// Map response body to model
+	state := clusterNodesDataSourceModel{
+		ClusterInitialized: types.BoolValue(cluster.GetClusterInitialized()),
+		ClusterDomain:      types.StringPointerValue(cluster.ClusterDomain),
+		ConfigLastSynced:   types.StringPointerValue(cluster.ConfigLastSynced),
+		Nodes:              []clusterNodeStateModel{},
+	}
+	for _, node := range cluster.ClusterNodes {
+		addresses, diags := types.ListValueFrom(ctx, types.StringType, node.IpAddresses)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Nodes = append(state.Nodes, clusterNodeStateModel{
+			ID:          types.Int32PointerValue(node.Id),
+			Name:        types.StringPointerValue(node.Name),
+			URL:         types.StringPointerValue(node.Url),
+			IPAddresses: addresses,
+			Type:        types.StringPointerValue(node.Type),
+			State:       types.StringPointerValue(node.State),
+			UpSince:     types.StringPointerValue(node.UpSince),
+			LastSeen:    types.StringPointerValue(node.LastSeen),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *clusterNodesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
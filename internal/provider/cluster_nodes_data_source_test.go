@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterNodesDataSource(t *testing.T) {
+	server := newFakeClusterServer(t, "dns.fake")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A server outside of a cluster has no nodes
+			{
+				Config: testAccProviderConfig(server) + `data "technitium_cluster_nodes" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "cluster_initialized", "false"),
+					resource.TestCheckNoResourceAttr("data.technitium_cluster_nodes.test", "cluster_domain"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "nodes.#", "0"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccClusterResourceConfig("") + `
+data "technitium_cluster_nodes" "test" {
+  depends_on = [technitium_cluster.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "cluster_initialized", "true"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "cluster_domain", "cluster.fake"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "config_last_synced", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "nodes.0.id", "1"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "nodes.0.name", "dns.fake"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "nodes.0.url", server.URL+"/"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "nodes.0.ip_addresses.0", "192.0.2.1"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "nodes.0.type", "Primary"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "nodes.0.state", "Self"),
+					resource.TestCheckResourceAttr("data.technitium_cluster_nodes.test", "nodes.0.last_seen", "2024-01-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccClusterNodesDataSource_unsupportedVersion(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + `data "technitium_cluster_nodes" "test" {}`,
+				ExpectError: regexp.MustCompile(`Clusters\s+require\s+Technitium\s+DNS\s+Server\s+14.0\s+or\s+later`),
+			},
+		},
+	})
+}
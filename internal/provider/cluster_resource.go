@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &clusterResource{}
+	_ resource.ResourceWithConfigure   = &clusterResource{}
+	_ resource.ResourceWithImportState = &clusterResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterResource{}
+)
+
+// NewClusterResource is a helper function to simplify the provider implementation.
+func NewClusterResource() resource.Resource {
+	return &clusterResource{}
+}
+
+// clusterResource initializes a cluster with the configured server as its
+// primary node.
+type clusterResource struct {
+	client *technitiumClient
+}
+
+// clusterResourceModel maps the resource schema data.
+type clusterResourceModel struct {
+	ID                              types.String `tfsdk:"id"`
+	ClusterDomain                   types.String `tfsdk:"cluster_domain"`
+	PrimaryNodeIPAddresses          types.List   `tfsdk:"primary_node_ip_addresses"`
+	HeartbeatRefreshIntervalSeconds types.Int32  `tfsdk:"heartbeat_refresh_interval_seconds"`
+	HeartbeatRetryIntervalSeconds   types.Int32  `tfsdk:"heartbeat_retry_interval_seconds"`
+	ConfigRefreshIntervalSeconds    types.Int32  `tfsdk:"config_refresh_interval_seconds"`
+	ConfigRetryIntervalSeconds      types.Int32  `tfsdk:"config_retry_interval_seconds"`
+	ForceDelete                     types.Bool   `tfsdk:"force_delete"`
+}
+
+// Metadata returns the resource type name.
+func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+// Schema defines the schema for the resource.
+func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	interval := func() schema.Int32Attribute {
+		return schema.Int32Attribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int32{
+				int32validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_domain": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_node_ip_addresses": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"heartbeat_refresh_interval_seconds": interval(),
+			"heartbeat_retry_interval_seconds":   interval(),
+			"config_refresh_interval_seconds":    interval(),
+			"config_retry_interval_seconds":      interval(),
+			"force_delete": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+// ModifyPlan rejects clusters on servers older than version 14.
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the cluster is destroyed or the provider is not
+	// configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	r.client.requireFeature(&resp.Diagnostics, path.Root("cluster_domain"), featureClustering)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan clusterResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var addresses []string
+	resp.Diagnostics.Append(plan.PrimaryNodeIPAddresses.ElementsAs(ctx, &addresses, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Initialize the cluster
+	cluster := r.client.ClusterAPI.InitCluster(ctx)
+	cluster = cluster.ClusterDomain(plan.ClusterDomain.ValueString())
+	cluster = cluster.PrimaryNodeIpAddresses(addresses)
+	answ, _, err := cluster.Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "create", "cluster", plan.ClusterDomain.ValueString(), err)
+		return
+	}
+
+	// Set the timing options that were configured
+	state := answ.Response
+	if hasClusterOptions(plan) {
+		state, err = r.setOptions(ctx, plan)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "configure", "cluster", plan.ClusterDomain.ValueString(), err)
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(plan.ClusterDomain.ValueString())
+	setClusterOptions(&plan, state)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state clusterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed cluster from Technitium
+	answ, _, err := r.client.ClusterAPI.GetClusterState(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "cluster", state.ClusterDomain.ValueString(), err)
+		return
+	}
+	cluster := answ.Response
+
+	// The cluster was deleted outside of Terraform, or the server is no
+	// longer its primary node.
+	primary := clusterSelf(cluster)
+	if !cluster.GetClusterInitialized() || primary == nil || primary.GetType() != "Primary" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Changing the addresses replaces the cluster, so a different order or
+	// notation of the same addresses is not drift.
+	var configured []string
+	if !state.PrimaryNodeIPAddresses.IsNull() {
+		resp.Diagnostics.Append(state.PrimaryNodeIPAddresses.ElementsAs(ctx, &configured, false)...)
+	}
+	if state.PrimaryNodeIPAddresses.IsNull() || !sameIPAddresses(configured, primary.IpAddresses) {
+		addresses, diags := types.ListValueFrom(ctx, types.StringType, primary.IpAddresses)
+		resp.Diagnostics.Append(diags...)
+		state.PrimaryNodeIPAddresses = addresses
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue(cluster.GetClusterDomain())
+	state.ClusterDomain = types.StringValue(cluster.GetClusterDomain())
+	setClusterOptions(&state, cluster)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan clusterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timing options can change without replacing the cluster.
+	cluster, err := r.setOptions(ctx, plan)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "update", "cluster", plan.ClusterDomain.ValueString(), err)
+		return
+	}
+	setClusterOptions(&plan, cluster)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state clusterResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the cluster
+	cluster := r.client.ClusterAPI.DeleteCluster(ctx)
+	if !state.ForceDelete.IsNull() {
+		cluster = cluster.ForceDelete(state.ForceDelete.ValueBool())
+	}
+	answ, _, err := cluster.Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "delete", "cluster", state.ClusterDomain.ValueString(), err)
+		return
+	}
+}
+
+// ImportState imports the cluster of the server by its domain.
+func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cluster_domain"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *clusterResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// setOptions sends the configured timing options to the primary node.
+func (r *clusterResource) setOptions(ctx context.Context, plan clusterResourceModel) (*technitium.ClusterState, error) {
+	options := r.client.ClusterAPI.SetClusterOptions(ctx)
+	if !plan.HeartbeatRefreshIntervalSeconds.IsUnknown() {
+		options = options.HeartbeatRefreshIntervalSeconds(plan.HeartbeatRefreshIntervalSeconds.ValueInt32())
+	}
+	if !plan.HeartbeatRetryIntervalSeconds.IsUnknown() {
+		options = options.HeartbeatRetryIntervalSeconds(plan.HeartbeatRetryIntervalSeconds.ValueInt32())
+	}
+	if !plan.ConfigRefreshIntervalSeconds.IsUnknown() {
+		options = options.ConfigRefreshIntervalSeconds(plan.ConfigRefreshIntervalSeconds.ValueInt32())
+	}
+	if !plan.ConfigRetryIntervalSeconds.IsUnknown() {
+		options = options.ConfigRetryIntervalSeconds(plan.ConfigRetryIntervalSeconds.ValueInt32())
+	}
+
+	answ, _, err := options.Execute()
+	if err = checkResponse(answ, err); err != nil {
+		return nil, err
+	}
+	return answ.Response, nil
+}
+
+// hasClusterOptions reports whether any timing option is configured.
+func hasClusterOptions(plan clusterResourceModel) bool {
+	return !plan.HeartbeatRefreshIntervalSeconds.IsUnknown() ||
+		!plan.HeartbeatRetryIntervalSeconds.IsUnknown() ||
+		!plan.ConfigRefreshIntervalSeconds.IsUnknown() ||
+		!plan.ConfigRetryIntervalSeconds.IsUnknown()
+}
+
+// setClusterOptions copies the timing options reported by the server.
+func setClusterOptions(model *clusterResourceModel, cluster *technitium.ClusterState) {
+	model.HeartbeatRefreshIntervalSeconds = types.Int32Value(cluster.GetHeartbeatRefreshIntervalSeconds())
+	model.HeartbeatRetryIntervalSeconds = types.Int32Value(cluster.GetHeartbeatRetryIntervalSeconds())
+	model.ConfigRefreshIntervalSeconds = types.Int32Value(cluster.GetConfigRefreshIntervalSeconds())
+	model.ConfigRetryIntervalSeconds = types.Int32Value(cluster.GetConfigRetryIntervalSeconds())
+}
+
+// clusterSelf returns the node that answered the cluster state call.
+func clusterSelf(cluster *technitium.ClusterState) *technitium.ClusterNode {
+	for i := range cluster.ClusterNodes {
+		if cluster.ClusterNodes[i].GetState() == "Self" {
+			return &cluster.ClusterNodes[i]
+		}
+	}
+	return nil
+}
+
+// sameIPAddresses reports whether both lists hold the same IP addresses, in
+// any order and notation.
+func sameIPAddresses(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	matched := make([]bool, len(b))
+next:
+	for _, x := range a {
+		for j, y := range b {
+			if !matched[j] && sameIPAddress(x, y) {
+				matched[j] = true
+				continue next
+			}
+		}
+		return false
+	}
+	return true
+}
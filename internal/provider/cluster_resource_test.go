@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// newFakeClusterServer returns a fake server that supports clustering.
+func newFakeClusterServer(t *testing.T, domain string) *fakeServer {
+	t.Helper()
+
+	server := newFakeServer(t)
+	server.version = "14.0"
+	server.settings["dnsServerDomain"] = domain
+	return server
+}
+
+func TestAccClusterResource(t *testing.T) {
+	server := newFakeClusterServer(t, "dns.fake")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccClusterResourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_cluster.test", "id", "cluster.fake"),
+					resource.TestCheckResourceAttr("technitium_cluster.test", "cluster_domain", "cluster.fake"),
+					resource.TestCheckResourceAttr("technitium_cluster.test", "primary_node_ip_addresses.#", "1"),
+					resource.TestCheckResourceAttr("technitium_cluster.test", "primary_node_ip_addresses.0", "192.0.2.1"),
+					resource.TestCheckResourceAttr("technitium_cluster.test", "heartbeat_refresh_interval_seconds", "30"),
+					resource.TestCheckResourceAttr("technitium_cluster.test", "config_refresh_interval_seconds", "900"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "technitium_cluster.test",
+				ImportState:                          true,
+				ImportStateId:                        "cluster.fake",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "cluster_domain",
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccClusterResourceConfig("heartbeat_refresh_interval_seconds = 60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_cluster.test", "heartbeat_refresh_interval_seconds", "60"),
+					resource.TestCheckResourceAttr("technitium_cluster.test", "config_refresh_interval_seconds", "900"),
+					func(*terraform.State) error {
+						server.mu.Lock()
+						defer server.mu.Unlock()
+						if server.cluster.heartbeatRefreshInterval != 60 {
+							return fmt.Errorf("heartbeat refresh interval is %d on the server, expected 60", server.cluster.heartbeatRefreshInterval)
+						}
+						return nil
+					},
+				),
+			},
+			// A cluster deleted outside of Terraform is initialized again
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.cluster = nil
+				},
+				Config: testAccProviderConfig(server) + testAccClusterResourceConfig("heartbeat_refresh_interval_seconds = 60"),
+				Check:  resource.TestCheckResourceAttr("technitium_cluster.test", "cluster_domain", "cluster.fake"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccClusterResource_unsupportedVersion(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccClusterResourceConfig(""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Clusters\s+require\s+Technitium\s+DNS\s+Server\s+14.0\s+or\s+later,\s+but\s+the\s+server\s+runs\s+version\s+13.0`),
+			},
+		},
+	})
+}
+
+func testAccClusterResourceConfig(options string) string {
+	return fmt.Sprintf(`
+resource "technitium_cluster" "test" {
+  cluster_domain            = "cluster.fake"
+  primary_node_ip_addresses = ["192.0.2.1"]
+  %s
+}
+`, options)
+}
+
+func testAccCheckClusterDestroy(server *fakeServer) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		if server.cluster != nil {
+			return fmt.Errorf("cluster %s still exists on the server", server.cluster.domain)
+		}
+		return nil
+	}
+}
+
+func TestAccClusterResource_addresses(t *testing.T) {
+	server := newFakeClusterServer(t, "dns.fake")
+	config := testAccProviderConfig(server) + `
+resource "technitium_cluster" "test" {
+  cluster_domain            = "cluster.fake"
+  primary_node_ip_addresses = ["192.0.2.1", "2001:db8::1"]
+}
+`
+	setAddresses := func(addresses ...string) func() {
+		return func() {
+			server.mu.Lock()
+			defer server.mu.Unlock()
+			server.cluster.nodes[0].ipAddresses = addresses
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// The server listing the same addresses differently is not a change
+			{
+				PreConfig: setAddresses("2001:db8:0:0:0:0:0:1", "192.0.2.1"),
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Other addresses replace the cluster
+			{
+				PreConfig: setAddresses("192.0.2.9"),
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_cluster.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dhcpLeasesDataSource{}
+	_ datasource.DataSourceWithConfigure = &dhcpLeasesDataSource{}
+)
+
+// dhcpLeasesDataSourceModel maps the data source schema data.
+type dhcpLeasesDataSourceModel struct {
+	Scope  types.String     `tfsdk:"scope"`
+	Leases []dhcpLeaseModel `tfsdk:"leases"`
+}
+
+// dhcpLeaseModel maps the lease schema data.
+type dhcpLeaseModel struct {
+	Scope            types.String `tfsdk:"scope"`
+	Type             types.String `tfsdk:"type"`
+	HardwareAddress  types.String `tfsdk:"hardware_address"`
+	ClientIdentifier types.String `tfsdk:"client_identifier"`
+	Address          types.String `tfsdk:"address"`
+	HostName         types.String `tfsdk:"host_name"`
+	LeaseObtained    types.String `tfsdk:"lease_obtained"`
+	LeaseExpires     types.String `tfsdk:"lease_expires"`
+}
+
+type dhcpLeasesDataSource struct {
+	client *technitiumClient
+}
+
+func NewDhcpLeasesDataSource() datasource.DataSource {
+	return &dhcpLeasesDataSource{}
+}
+
+func (d *dhcpLeasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dhcp_leases"
+}
+
+// Schema defines the schema for the data source.
+func (d *dhcpLeasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				Optional: true,
+			},
+			"leases": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"scope": schema.StringAttribute{
+							Computed: true,
+						},
+						"type": schema.StringAttribute{
+							Computed: true,
+						},
+						"hardware_address": schema.StringAttribute{
+							Computed: true,
+						},
+						"client_identifier": schema.StringAttribute{
+							Computed: true,
+						},
+						"address": schema.StringAttribute{
+							Computed: true,
+						},
+						"host_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"lease_obtained": schema.StringAttribute{
+							Computed: true,
+						},
+						"lease_expires": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dhcpLeasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dhcpLeasesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	answ, _, err := d.client.DhcpAPI.ListDhcpLeases(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "list", "dhcp leases", "", err)
+		return
+	}
+
+	// Map response body to model, keeping only the leases of the scope when
+	// one is given
+	state.Leases = []dhcpLeaseModel{}
+	for _, lease := range answ.Response.Leases {
+		if !state.Scope.IsNull() && lease.GetScope() != state.Scope.ValueString() {
+			continue
+		}
+
+		state.Leases = append(state.Leases, dhcpLeaseModel{
+			Scope:            types.StringValue(lease.GetScope()),
+			Type:             types.StringValue(lease.GetType()),
+			HardwareAddress:  types.StringValue(lease.GetHardwareAddress()),
+			ClientIdentifier: stringValueOrNull(lease.GetClientIdentifier()),
+			Address:          types.StringValue(lease.GetAddress()),
+			HostName:         stringValueOrNull(lease.GetHostName()),
+			LeaseObtained:    types.StringValue(lease.GetLeaseObtained()),
+			LeaseExpires:     types.StringValue(lease.GetLeaseExpires()),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dhcpLeasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDhcpLeasesDataSource(t *testing.T) {
+	server := newFakeServer(t)
+	server.scopes["lan"] = &fakeDhcpScope{
+		name:    "lan",
+		enabled: true,
+		options: map[string]interface{}{},
+		leases: []map[string]interface{}{
+			{
+				"type":             "Reserved",
+				"hardwareAddress":  "00-11-22-33-44-55",
+				"clientIdentifier": "1-001122334455",
+				"address":          "192.168.1.50",
+				"hostName":         "printer.home.arpa",
+				"leaseObtained":    "2024-01-01T00:00:00Z",
+				"leaseExpires":     "2024-01-08T00:00:00Z",
+			},
+			{
+				"type":             "Dynamic",
+				"hardwareAddress":  "66-77-88-99-AA-BB",
+				"clientIdentifier": "1-66778899AABB",
+				"address":          "192.168.1.11",
+				"hostName":         "",
+				"leaseObtained":    "2024-01-02T00:00:00Z",
+				"leaseExpires":     "2024-01-09T00:00:00Z",
+			},
+		},
+	}
+	server.scopes["guest"] = &fakeDhcpScope{
+		name:    "guest",
+		enabled: true,
+		options: map[string]interface{}{},
+		leases: []map[string]interface{}{
+			{
+				"type":            "Dynamic",
+				"hardwareAddress": "CC-DD-EE-FF-00-11",
+				"address":         "10.0.0.20",
+				"leaseObtained":   "2024-01-03T00:00:00Z",
+				"leaseExpires":    "2024-01-03T04:00:00Z",
+			},
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "technitium_dhcp_leases" "all" {}
+
+data "technitium_dhcp_leases" "lan" {
+  scope = "lan"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.all", "leases.#", "3"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.all", "leases.0.scope", "guest"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.all", "leases.0.address", "10.0.0.20"),
+					resource.TestCheckNoResourceAttr("data.technitium_dhcp_leases.all", "leases.0.host_name"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.lan", "leases.#", "2"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.lan", "leases.0.scope", "lan"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.lan", "leases.0.type", "Reserved"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.lan", "leases.0.hardware_address", "00-11-22-33-44-55"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.lan", "leases.0.client_identifier", "1-001122334455"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.lan", "leases.0.host_name", "printer.home.arpa"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.lan", "leases.0.lease_obtained", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.lan", "leases.0.lease_expires", "2024-01-08T00:00:00Z"),
+					resource.TestCheckResourceAttr("data.technitium_dhcp_leases.lan", "leases.1.type", "Dynamic"),
+					resource.TestCheckNoResourceAttr("data.technitium_dhcp_leases.lan", "leases.1.host_name"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dhcpReservedLeaseResource{}
+	_ resource.ResourceWithConfigure   = &dhcpReservedLeaseResource{}
+	_ resource.ResourceWithImportState = &dhcpReservedLeaseResource{}
+	_ resource.ResourceWithModifyPlan  = &dhcpReservedLeaseResource{}
+)
+
+// hardwareAddressPattern matches a MAC address written with colons or
+// dashes, the two forms the web console accepts.
+var hardwareAddressPattern = regexp.MustCompile(`^[0-9A-Fa-f]{2}([:-][0-9A-Fa-f]{2}){5}$`)
+
+// NewDhcpReservedLeaseResource is a helper function to simplify the provider implementation.
+func NewDhcpReservedLeaseResource() resource.Resource {
+	return &dhcpReservedLeaseResource{}
+}
+
+// dhcpReservedLeaseResource is the resource implementation.
+type dhcpReservedLeaseResource struct {
+	client *technitiumClient
+}
+
+// dhcpReservedLeaseResourceModel maps the resource schema data.
+type dhcpReservedLeaseResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Scope           types.String `tfsdk:"scope"`
+	HardwareAddress types.String `tfsdk:"hardware_address"`
+	IPAddress       types.String `tfsdk:"ip_address"`
+	HostName        types.String `tfsdk:"host_name"`
+	Comments        types.String `tfsdk:"comments"`
+}
+
+// Metadata returns the resource type name.
+func (r *dhcpReservedLeaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dhcp_reserved_lease"
+}
+
+// Schema defines the schema for the resource.
+func (r *dhcpReservedLeaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hardware_address": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(hardwareAddressPattern, "must be a MAC address such as 00:11:22:33:44:55"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				Required:   true,
+				Validators: []validator.String{ipv4Address()},
+			},
+			"host_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"comments": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan checks that the address lies in the range of the scope, when
+// the scope already exists.
+func (r *dhcpReservedLeaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan dhcpReservedLeaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Scope.IsUnknown() || plan.IPAddress.IsUnknown() {
+		return
+	}
+
+	scope, err := findDhcpScope(ctx, r.client, plan.Scope.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "read", "dhcp scope", plan.Scope.ValueString(), err)
+		return
+	}
+	if scope != nil {
+		checkInDhcpScope(&resp.Diagnostics, scope.GetName(), scope.GetStartingAddress(), scope.GetEndingAddress(), plan.IPAddress.ValueString())
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dhcpReservedLeaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dhcpReservedLeaseResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The scope may have been created in the same run, after the plan.
+	answ, _, err := r.client.DhcpAPI.GetDhcpScope(ctx).Name(plan.Scope.ValueString()).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "create", "dhcp reserved lease", plan.HardwareAddress.ValueString(), err)
+		return
+	}
+	checkInDhcpScope(&resp.Diagnostics, plan.Scope.ValueString(), answ.Response.GetStartingAddress(), answ.Response.GetEndingAddress(), plan.IPAddress.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new reserved lease
+	if err := r.addLease(ctx, plan); err != nil {
+		addAPIError(&resp.Diagnostics, "create", "dhcp reserved lease", plan.HardwareAddress.ValueString(), err)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(plan.Scope.ValueString() + ":" + plan.HardwareAddress.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dhcpReservedLeaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dhcpReservedLeaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting the scope deletes its reservations.
+	scope, err := findDhcpScope(ctx, r.client, state.Scope.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "read", "dhcp reserved lease", state.HardwareAddress.ValueString(), err)
+		return
+	}
+	if scope == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Get refreshed reservation from Technitium
+	answ, _, err := r.client.DhcpAPI.GetDhcpScope(ctx).Name(state.Scope.ValueString()).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "dhcp reserved lease", state.HardwareAddress.ValueString(), err)
+		return
+	}
+
+	// The server answers with its own spelling of the hardware address, so
+	// the one in state is kept.
+	var lease *technitium.DhcpReservedLease
+	for i := range answ.Response.ReservedLeases {
+		if sameHardwareAddress(answ.Response.ReservedLeases[i].GetHardwareAddress(), state.HardwareAddress.ValueString()) {
+			lease = &answ.Response.ReservedLeases[i]
+			break
+		}
+	}
+	if lease == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.Scope.ValueString() + ":" + state.HardwareAddress.ValueString())
+	state.IPAddress = types.StringValue(lease.GetAddress())
+	state.HostName = stringValueOrNull(lease.GetHostName())
+	state.Comments = stringValueOrNull(lease.GetComments())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dhcpReservedLeaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state dhcpReservedLeaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Reservations cannot be edited and the server allows one per hardware
+	// address, so the old one is removed first and put back if the new one
+	// cannot be added.
+	if err := r.removeLease(ctx, state); err != nil {
+		addAPIError(&resp.Diagnostics, "update", "dhcp reserved lease", plan.HardwareAddress.ValueString(), err)
+		return
+	}
+	if err := r.addLease(ctx, plan); err != nil {
+		addAPIError(&resp.Diagnostics, "update", "dhcp reserved lease", plan.HardwareAddress.ValueString(), err)
+		if restoreErr := r.addLease(ctx, state); restoreErr != nil {
+			addAPIError(&resp.Diagnostics, "restore", "dhcp reserved lease", state.HardwareAddress.ValueString(), restoreErr)
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dhcpReservedLeaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dhcpReservedLeaseResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing reserved lease
+	if err := r.removeLease(ctx, state); err != nil {
+		addAPIError(&resp.Diagnostics, "delete", "dhcp reserved lease", state.HardwareAddress.ValueString(), err)
+		return
+	}
+}
+
+// ImportState imports a reservation with the ID scope:hardware_address.
+func (r *dhcpReservedLeaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scope, hardwareAddress, ok := strings.Cut(req.ID, ":")
+	if !ok || scope == "" || !hardwareAddressPattern.MatchString(hardwareAddress) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: scope:hardware_address. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope"), scope)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hardware_address"), hardwareAddress)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dhcpReservedLeaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dhcpReservedLeaseResource) addLease(ctx context.Context, model dhcpReservedLeaseResourceModel) error {
+	lease := r.client.DhcpAPI.AddReservedLease(ctx)
+	lease = lease.Name(model.Scope.ValueString())
+	lease = lease.HardwareAddress(model.HardwareAddress.ValueString())
+	lease = lease.IpAddress(model.IPAddress.ValueString())
+	if !model.HostName.IsNull() {
+		lease = lease.HostName(model.HostName.ValueString())
+	}
+	if !model.Comments.IsNull() {
+		lease = lease.Comments(model.Comments.ValueString())
+	}
+	answ, _, err := lease.Execute()
+	return checkResponse(answ, err)
+}
+
+func (r *dhcpReservedLeaseResource) removeLease(ctx context.Context, model dhcpReservedLeaseResourceModel) error {
+	lease := r.client.DhcpAPI.RemoveReservedLease(ctx)
+	lease = lease.Name(model.Scope.ValueString())
+	lease = lease.HardwareAddress(model.HardwareAddress.ValueString())
+	answ, _, err := lease.Execute()
+	return checkResponse(answ, err)
+}
+
+// checkInDhcpScope reports an error when address is outside the range of
+// the scope.
+func checkInDhcpScope(diags *diag.Diagnostics, scope string, start string, end string, address string) {
+	first, errFirst := netip.ParseAddr(start)
+	last, errLast := netip.ParseAddr(end)
+	addr, err := netip.ParseAddr(address)
+	if errFirst != nil || errLast != nil || err != nil {
+		// The server did not report a range, or the address is invalid
+		// and already reported by the validator.
+		return
+	}
+
+	if addr.Less(first) || last.Less(addr) {
+		diags.AddAttributeError(
+			path.Root("ip_address"),
+			"Address Outside DHCP Scope",
+			fmt.Sprintf("The address %s is outside the range %s - %s of dhcp scope %s.", address, start, end, scope),
+		)
+	}
+}
+
+// sameHardwareAddress reports whether a and b are the same MAC address,
+// ignoring case and separators.
+func sameHardwareAddress(a string, b string) bool {
+	normalize := strings.NewReplacer(":", "", "-", "")
+	return strings.EqualFold(normalize.Replace(a), normalize.Replace(b))
+}
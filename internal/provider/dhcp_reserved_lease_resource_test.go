@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDhcpReservedLeaseResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDhcpReservedLeaseDestroy(server, "lan", "00-11-22-33-44-55"),
+		Steps: []resource.TestStep{
+			// Create and Read testing, together with the scope
+			{
+				Config: testAccProviderConfig(server) + testAccDhcpReservedLeaseResourceConfig("192.168.1.50", `host_name = "printer"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dhcp_reserved_lease.test", "id", "lan:00:11:22:33:44:55"),
+					resource.TestCheckResourceAttr("technitium_dhcp_reserved_lease.test", "hardware_address", "00:11:22:33:44:55"),
+					resource.TestCheckResourceAttr("technitium_dhcp_reserved_lease.test", "ip_address", "192.168.1.50"),
+					resource.TestCheckResourceAttr("technitium_dhcp_reserved_lease.test", "host_name", "printer"),
+					resource.TestCheckNoResourceAttr("technitium_dhcp_reserved_lease.test", "comments"),
+					testAccCheckDhcpReservedLease(server, "lan", "00-11-22-33-44-55", "192.168.1.50"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "technitium_dhcp_reserved_lease.test",
+				ImportState:       true,
+				ImportStateId:     "lan:00:11:22:33:44:55",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDhcpReservedLeaseResourceConfig("192.168.1.60", `comments = "2nd floor"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dhcp_reserved_lease.test", "ip_address", "192.168.1.60"),
+					resource.TestCheckNoResourceAttr("technitium_dhcp_reserved_lease.test", "host_name"),
+					resource.TestCheckResourceAttr("technitium_dhcp_reserved_lease.test", "comments", "2nd floor"),
+					testAccCheckDhcpReservedLease(server, "lan", "00-11-22-33-44-55", "192.168.1.60"),
+				),
+			},
+			// An address edited outside of Terraform is detected and reverted
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					fakeReservedLeases(server.scopes["lan"])[0]["address"] = "192.168.1.99"
+				},
+				Config: testAccProviderConfig(server) + testAccDhcpReservedLeaseResourceConfig("192.168.1.60", `comments = "2nd floor"`),
+				Check:  testAccCheckDhcpReservedLease(server, "lan", "00-11-22-33-44-55", "192.168.1.60"),
+			},
+			// A reservation removed outside of Terraform is created again
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					delete(server.scopes["lan"].options, "reservedLeases")
+				},
+				Config: testAccProviderConfig(server) + testAccDhcpReservedLeaseResourceConfig("192.168.1.60", `comments = "2nd floor"`),
+				Check:  testAccCheckDhcpReservedLease(server, "lan", "00-11-22-33-44-55", "192.168.1.60"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDhcpReservedLeaseResource_updateFails(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDhcpReservedLeaseDestroy(server, "lan", "00-11-22-33-44-55"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccDhcpReservedLeaseResourceConfig("192.168.1.50", ""),
+			},
+			// The new address is taken, so the old reservation is put back
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					scope := server.scopes["lan"]
+					scope.options["reservedLeases"] = append(fakeReservedLeases(scope), map[string]string{
+						"hardwareAddress": "66-77-88-99-AA-BB",
+						"address":         "192.168.1.60",
+					})
+				},
+				Config:      testAccProviderConfig(server) + testAccDhcpReservedLeaseResourceConfig("192.168.1.60", ""),
+				ExpectError: regexp.MustCompile(`192.168.1.60\s+is\s+already\s+reserved`),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccDhcpReservedLeaseResourceConfig("192.168.1.50", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: testAccCheckDhcpReservedLease(server, "lan", "00-11-22-33-44-55", "192.168.1.50"),
+			},
+		},
+	})
+}
+
+func TestAccDhcpReservedLeaseResource_outsideScope(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The scope is created in the same run, so the range is
+			// checked before adding the reservation.
+			{
+				Config:      testAccProviderConfig(server) + testAccDhcpReservedLeaseResourceConfig("192.168.1.250", ""),
+				ExpectError: regexp.MustCompile(`The\s+address\s+192.168.1.250\s+is\s+outside\s+the\s+range\s+192.168.1.10\s+-\s+192.168.1.200`),
+			},
+			// An existing scope is checked at plan time.
+			{
+				Config:      testAccProviderConfig(server) + testAccDhcpReservedLeaseResourceConfig("192.168.1.5", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Address\s+Outside\s+DHCP\s+Scope`),
+			},
+		},
+	})
+}
+
+func testAccDhcpReservedLeaseResourceConfig(address string, options string) string {
+	return testAccDhcpScopeResourceConfig("lan", "") + fmt.Sprintf(`
+resource "technitium_dhcp_reserved_lease" "test" {
+  scope            = technitium_dhcp_scope.test.name
+  hardware_address = "00:11:22:33:44:55"
+  ip_address       = %q
+  %s
+}
+`, address, options)
+}
+
+func testAccCheckDhcpReservedLease(server *fakeServer, scope string, hardwareAddress string, address string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		if _, ok := server.scopes[scope]; !ok {
+			return fmt.Errorf("dhcp scope %s does not exist on the server", scope)
+		}
+		for _, lease := range fakeReservedLeases(server.scopes[scope]) {
+			if lease["hardwareAddress"] != hardwareAddress {
+				continue
+			}
+			if lease["address"] != address {
+				return fmt.Errorf("reserved lease %s has address %s, expected %s", hardwareAddress, lease["address"], address)
+			}
+			return nil
+		}
+		return fmt.Errorf("reserved lease %s does not exist in dhcp scope %s", hardwareAddress, scope)
+	}
+}
+
+func testAccCheckDhcpReservedLeaseDestroy(server *fakeServer, scope string, hardwareAddress string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		if _, ok := server.scopes[scope]; !ok {
+			return nil
+		}
+		for _, lease := range fakeReservedLeases(server.scopes[scope]) {
+			if lease["hardwareAddress"] == hardwareAddress {
+				return fmt.Errorf("reserved lease %s still exists in dhcp scope %s", hardwareAddress, scope)
+			}
+		}
+		return nil
+	}
+}
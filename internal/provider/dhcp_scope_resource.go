@@ -0,0 +1,636 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dhcpScopeResource{}
+	_ resource.ResourceWithConfigure   = &dhcpScopeResource{}
+	_ resource.ResourceWithImportState = &dhcpScopeResource{}
+	_ resource.ResourceWithModifyPlan  = &dhcpScopeResource{}
+)
+
+// NewDhcpScopeResource is a helper function to simplify the provider implementation.
+func NewDhcpScopeResource() resource.Resource {
+	return &dhcpScopeResource{}
+}
+
+// dhcpScopeResource is the resource implementation.
+type dhcpScopeResource struct {
+	client *technitiumClient
+}
+
+// dhcpScopeResourceModel maps the resource schema data.
+type dhcpScopeResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	StartingAddress      types.String `tfsdk:"starting_address"`
+	EndingAddress        types.String `tfsdk:"ending_address"`
+	SubnetMask           types.String `tfsdk:"subnet_mask"`
+	LeaseTimeDays        types.Int32  `tfsdk:"lease_time_days"`
+	LeaseTimeHours       types.Int32  `tfsdk:"lease_time_hours"`
+	LeaseTimeMinutes     types.Int32  `tfsdk:"lease_time_minutes"`
+	RouterAddress        types.String `tfsdk:"router_address"`
+	UseThisDnsServer     types.Bool   `tfsdk:"use_this_dns_server"`
+	DnsServers           types.List   `tfsdk:"dns_servers"`
+	DomainName           types.String `tfsdk:"domain_name"`
+	DomainSearchList     types.List   `tfsdk:"domain_search_list"`
+	NtpServers           types.List   `tfsdk:"ntp_servers"`
+	NtpServerDomainNames types.List   `tfsdk:"ntp_server_domain_names"`
+	Exclusions           types.List   `tfsdk:"exclusions"`
+	StaticRoutes         types.List   `tfsdk:"static_routes"`
+	VendorInfo           types.List   `tfsdk:"vendor_info"`
+	DnsUpdates           types.Bool   `tfsdk:"dns_updates"`
+	DnsTtl               types.Int32  `tfsdk:"dns_ttl"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+}
+
+// dhcpExclusionModel maps an excluded address range.
+type dhcpExclusionModel struct {
+	StartingAddress types.String `tfsdk:"starting_address"`
+	EndingAddress   types.String `tfsdk:"ending_address"`
+}
+
+// dhcpStaticRouteModel maps a classless static route.
+type dhcpStaticRouteModel struct {
+	Destination types.String `tfsdk:"destination"`
+	SubnetMask  types.String `tfsdk:"subnet_mask"`
+	Router      types.String `tfsdk:"router"`
+}
+
+// dhcpVendorInfoModel maps vendor specific information.
+type dhcpVendorInfoModel struct {
+	Identifier  types.String `tfsdk:"identifier"`
+	Information types.String `tfsdk:"information"`
+}
+
+var (
+	dhcpExclusionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"starting_address": types.StringType,
+		"ending_address":   types.StringType,
+	}}
+	dhcpStaticRouteType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"destination": types.StringType,
+		"subnet_mask": types.StringType,
+		"router":      types.StringType,
+	}}
+	dhcpVendorInfoType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"identifier":  types.StringType,
+		"information": types.StringType,
+	}}
+)
+
+// Metadata returns the resource type name.
+func (r *dhcpScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dhcp_scope"
+}
+
+// Schema defines the schema for the resource.
+func (r *dhcpScopeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	address := func(required bool) schema.StringAttribute {
+		return schema.StringAttribute{
+			Required:   required,
+			Optional:   !required,
+			Validators: []validator.String{ipv4Address()},
+		}
+	}
+	addresses := func() schema.ListAttribute {
+		return schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(ipv4Address()),
+			},
+		}
+	}
+	names := func() schema.ListAttribute {
+		return schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+		}
+	}
+	leaseTime := func() schema.Int32Attribute {
+		return schema.Int32Attribute{
+			Optional: true,
+			Computed: true,
+			Validators: []validator.Int32{
+				int32validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int32{
+				int32planmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"starting_address":   address(true),
+			"ending_address":     address(true),
+			"subnet_mask":        address(true),
+			"lease_time_days":    leaseTime(),
+			"lease_time_hours":   leaseTime(),
+			"lease_time_minutes": leaseTime(),
+			"router_address":     address(false),
+			"use_this_dns_server": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_servers": addresses(),
+			"domain_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"domain_search_list":      names(),
+			"ntp_servers":             addresses(),
+			"ntp_server_domain_names": names(),
+			"exclusions": schema.ListNestedAttribute{
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"starting_address": address(true),
+						"ending_address":   address(true),
+					},
+				},
+			},
+			"static_routes": schema.ListNestedAttribute{
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"destination": address(true),
+						"subnet_mask": address(true),
+						"router":      address(true),
+					},
+				},
+			},
+			"vendor_info": schema.ListNestedAttribute{
+				Optional: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identifier": schema.StringAttribute{
+							Required: true,
+						},
+						"information": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"dns_updates": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_ttl": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// ModifyPlan keeps the ID in step with the scope name, which can change
+// without replacing the scope.
+func (r *dhcpScopeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || name.IsUnknown() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), name)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dhcpScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dhcpScopeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Setting a scope modifies an existing scope of the same name, which
+	// must not be taken over silently.
+	existing, err := findDhcpScope(ctx, r.client, plan.Name.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "create", "dhcp scope", plan.Name.ValueString(), err)
+		return
+	}
+	if existing != nil {
+		resp.Diagnostics.AddError(
+			"Error creating dhcp scope",
+			"Could not create dhcp scope "+plan.Name.ValueString()+", a scope with this name already exists. Import it to manage it with Terraform.",
+		)
+		return
+	}
+
+	// Create new dhcp scope
+	if err := r.setScope(ctx, plan.Name.ValueString(), &plan, &resp.Diagnostics); err != nil {
+		addAPIError(&resp.Diagnostics, "create", "dhcp scope", plan.Name.ValueString(), err)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The scope exists from here on, so it is saved even if it cannot be
+	// enabled or disabled, and Terraform taints it.
+	enableErr := r.setEnabled(ctx, plan)
+
+	// Map response body to schema and populate Computed attribute values
+	r.refresh(ctx, &plan, &resp.Diagnostics, "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if enableErr != nil {
+		addAPIError(&resp.Diagnostics, "create", "dhcp scope", plan.Name.ValueString(), enableErr)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dhcpScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dhcpScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The scope was deleted outside of Terraform.
+	existing, err := findDhcpScope(ctx, r.client, state.Name.ValueString())
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "read", "dhcp scope", state.Name.ValueString(), err)
+		return
+	}
+	if existing == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Get refreshed scope from Technitium
+	r.refresh(ctx, &state, &resp.Diagnostics, "read")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dhcpScopeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state dhcpScopeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the scope, renaming it when the name changed
+	if err := r.setScope(ctx, state.Name.ValueString(), &plan, &resp.Diagnostics); err != nil {
+		addAPIError(&resp.Diagnostics, "update", "dhcp scope", state.Name.ValueString(), err)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the updated scope even if it cannot be enabled or disabled.
+	enableErr := r.setEnabled(ctx, plan)
+
+	r.refresh(ctx, &plan, &resp.Diagnostics, "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if enableErr != nil {
+		addAPIError(&resp.Diagnostics, "update", "dhcp scope", plan.Name.ValueString(), enableErr)
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dhcpScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dhcpScopeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing scope
+	answ, _, err := r.client.DhcpAPI.DeleteDhcpScope(ctx).Name(state.Name.ValueString()).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "delete", "dhcp scope", state.Name.ValueString(), err)
+		return
+	}
+}
+
+// ImportState imports a scope by its name.
+func (r *dhcpScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dhcpScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// findDhcpScope returns the summary of the named scope, or nil if the server
+// has no such scope.
+func findDhcpScope(ctx context.Context, client *technitiumClient, name string) (*technitium.DhcpScopeSummary, error) {
+	answ, _, err := client.DhcpAPI.ListDhcpScopes(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		return nil, err
+	}
+	for i := range answ.Response.Scopes {
+		if answ.Response.Scopes[i].GetName() == name {
+			return &answ.Response.Scopes[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// setScope sends the planned configuration of the scope currently called
+// name. Options that are not configured are cleared, except those the server
+// computes.
+func (r *dhcpScopeResource) setScope(ctx context.Context, name string, plan *dhcpScopeResourceModel, diags *diag.Diagnostics) error {
+	dnsServers := stringListElements(ctx, plan.DnsServers, diags)
+	domainSearchList := stringListElements(ctx, plan.DomainSearchList, diags)
+	ntpServers := stringListElements(ctx, plan.NtpServers, diags)
+	ntpServerDomainNames := stringListElements(ctx, plan.NtpServerDomainNames, diags)
+
+	var exclusions []dhcpExclusionModel
+	var staticRoutes []dhcpStaticRouteModel
+	var vendorInfo []dhcpVendorInfoModel
+	diags.Append(plan.Exclusions.ElementsAs(ctx, &exclusions, false)...)
+	diags.Append(plan.StaticRoutes.ElementsAs(ctx, &staticRoutes, false)...)
+	diags.Append(plan.VendorInfo.ElementsAs(ctx, &vendorInfo, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	scope := r.client.DhcpAPI.SetDhcpScope(ctx)
+	scope = scope.Name(name)
+	if plan.Name.ValueString() != name {
+		scope = scope.NewName(plan.Name.ValueString())
+	}
+	scope = scope.StartingAddress(plan.StartingAddress.ValueString())
+	scope = scope.EndingAddress(plan.EndingAddress.ValueString())
+	scope = scope.SubnetMask(plan.SubnetMask.ValueString())
+	scope = scope.RouterAddress(plan.RouterAddress.ValueString())
+	scope = scope.DomainName(plan.DomainName.ValueString())
+	scope = scope.DnsServers(dnsServers)
+	scope = scope.DomainSearchList(domainSearchList)
+	scope = scope.NtpServers(ntpServers)
+	scope = scope.NtpServerDomainNames(ntpServerDomainNames)
+
+	rows := make([][]string, 0, len(exclusions))
+	for _, exclusion := range exclusions {
+		rows = append(rows, []string{exclusion.StartingAddress.ValueString(), exclusion.EndingAddress.ValueString()})
+	}
+	scope = scope.Exclusions(rows)
+
+	rows = make([][]string, 0, len(staticRoutes))
+	for _, route := range staticRoutes {
+		rows = append(rows, []string{route.Destination.ValueString(), route.SubnetMask.ValueString(), route.Router.ValueString()})
+	}
+	scope = scope.StaticRoutes(rows)
+
+	rows = make([][]string, 0, len(vendorInfo))
+	for _, info := range vendorInfo {
+		rows = append(rows, []string{info.Identifier.ValueString(), info.Information.ValueString()})
+	}
+	scope = scope.VendorInfo(rows)
+
+	if !plan.LeaseTimeDays.IsUnknown() {
+		scope = scope.LeaseTimeDays(plan.LeaseTimeDays.ValueInt32())
+	}
+	if !plan.LeaseTimeHours.IsUnknown() {
+		scope = scope.LeaseTimeHours(plan.LeaseTimeHours.ValueInt32())
+	}
+	if !plan.LeaseTimeMinutes.IsUnknown() {
+		scope = scope.LeaseTimeMinutes(plan.LeaseTimeMinutes.ValueInt32())
+	}
+	if !plan.UseThisDnsServer.IsUnknown() {
+		scope = scope.UseThisDnsServer(plan.UseThisDnsServer.ValueBool())
+	}
+	if !plan.DnsUpdates.IsUnknown() {
+		scope = scope.DnsUpdates(plan.DnsUpdates.ValueBool())
+	}
+	if !plan.DnsTtl.IsUnknown() {
+		scope = scope.DnsTtl(plan.DnsTtl.ValueInt32())
+	}
+
+	answ, _, err := scope.Execute()
+	return checkResponse(answ, err)
+}
+
+// setEnabled enables or disables the scope as planned. New scopes start
+// disabled.
+func (r *dhcpScopeResource) setEnabled(ctx context.Context, plan dhcpScopeResourceModel) error {
+	var (
+		answ *technitium.StatusResponse
+		err  error
+	)
+	if plan.Enabled.ValueBool() {
+		answ, _, err = r.client.DhcpAPI.EnableDhcpScope(ctx).Name(plan.Name.ValueString()).Execute()
+	} else {
+		answ, _, err = r.client.DhcpAPI.DisableDhcpScope(ctx).Name(plan.Name.ValueString()).Execute()
+	}
+	return checkResponse(answ, err)
+}
+
+// refresh reads the scope named in model back from the server.
+func (r *dhcpScopeResource) refresh(ctx context.Context, model *dhcpScopeResourceModel, diags *diag.Diagnostics, operation string) {
+	name := model.Name.ValueString()
+
+	summary, err := findDhcpScope(ctx, r.client, name)
+	if err == nil && summary == nil {
+		err = &APIError{Status: "error", Message: "DHCP scope does not exist: " + name}
+	}
+	if err != nil {
+		addAPIError(diags, operation, "dhcp scope", name, err)
+		return
+	}
+
+	answ, _, err := r.client.DhcpAPI.GetDhcpScope(ctx).Name(name).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(diags, operation, "dhcp scope", name, err)
+		return
+	}
+	scope := answ.Response
+
+	exclusions := make([]dhcpExclusionModel, 0, len(scope.Exclusions))
+	for _, exclusion := range scope.Exclusions {
+		exclusions = append(exclusions, dhcpExclusionModel{
+			StartingAddress: types.StringPointerValue(exclusion.StartingAddress),
+			EndingAddress:   types.StringPointerValue(exclusion.EndingAddress),
+		})
+	}
+	staticRoutes := make([]dhcpStaticRouteModel, 0, len(scope.StaticRoutes))
+	for _, route := range scope.StaticRoutes {
+		staticRoutes = append(staticRoutes, dhcpStaticRouteModel{
+			Destination: types.StringPointerValue(route.Destination),
+			SubnetMask:  types.StringPointerValue(route.SubnetMask),
+			Router:      types.StringPointerValue(route.Router),
+		})
+	}
+	vendorInfo := make([]dhcpVendorInfoModel, 0, len(scope.VendorInfo))
+	for _, info := range scope.VendorInfo {
+		vendorInfo = append(vendorInfo, dhcpVendorInfoModel{
+			Identifier:  types.StringPointerValue(info.Identifier),
+			Information: types.StringPointerValue(info.Information),
+		})
+	}
+
+	model.ID = types.StringValue(name)
+	model.StartingAddress = types.StringValue(scope.GetStartingAddress())
+	model.EndingAddress = types.StringValue(scope.GetEndingAddress())
+	model.SubnetMask = types.StringValue(scope.GetSubnetMask())
+	model.LeaseTimeDays = types.Int32Value(scope.GetLeaseTimeDays())
+	model.LeaseTimeHours = types.Int32Value(scope.GetLeaseTimeHours())
+	model.LeaseTimeMinutes = types.Int32Value(scope.GetLeaseTimeMinutes())
+	model.RouterAddress = stringValueOrNull(scope.GetRouterAddress())
+	model.UseThisDnsServer = types.BoolValue(scope.GetUseThisDnsServer())
+	model.DnsServers = stringListValue(ctx, scope.DnsServers, diags)
+	model.DomainName = stringValueOrNull(scope.GetDomainName())
+	model.DomainSearchList = stringListValue(ctx, scope.DomainSearchList, diags)
+	model.NtpServers = stringListValue(ctx, scope.NtpServers, diags)
+	model.NtpServerDomainNames = stringListValue(ctx, scope.NtpServerDomainNames, diags)
+	model.Exclusions = objectListValue(ctx, dhcpExclusionType, exclusions, diags)
+	model.StaticRoutes = objectListValue(ctx, dhcpStaticRouteType, staticRoutes, diags)
+	model.VendorInfo = objectListValue(ctx, dhcpVendorInfoType, vendorInfo, diags)
+	model.DnsUpdates = types.BoolValue(scope.GetDnsUpdates())
+	model.DnsTtl = types.Int32Value(scope.GetDnsTtl())
+	model.Enabled = types.BoolValue(summary.GetEnabled())
+}
+
+// stringValueOrNull maps an empty string, which the server uses for options
+// that are not set, to null.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// stringListValue maps a list option to a list attribute, null when empty.
+func stringListValue(ctx context.Context, values []string, diags *diag.Diagnostics) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return list
+}
+
+// objectListValue maps rows of a table option to a nested list attribute,
+// null when empty.
+func objectListValue[T any](ctx context.Context, elementType types.ObjectType, rows []T, diags *diag.Diagnostics) types.List {
+	if len(rows) == 0 {
+		return types.ListNull(elementType)
+	}
+	list, d := types.ListValueFrom(ctx, elementType, rows)
+	diags.Append(d...)
+	return list
+}
+
+// stringListElements returns the values of a list attribute, nil when null.
+func stringListElements(ctx context.Context, list types.List, diags *diag.Diagnostics) []string {
+	var values []string
+	diags.Append(list.ElementsAs(ctx, &values, false)...)
+	return values
+}
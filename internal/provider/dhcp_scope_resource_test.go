@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDhcpScopeResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDhcpScopeDestroy(server, "lan"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDhcpScopeResourceConfig("lan", `
+  router_address     = "192.168.1.1"
+  dns_servers        = ["192.168.1.2", "192.168.1.3"]
+  domain_name        = "home.arpa"
+  domain_search_list = ["home.arpa", "example.com"]
+  ntp_servers        = ["192.168.1.4"]
+  lease_time_hours   = 12
+  dns_ttl            = 300
+
+  exclusions = [
+    { starting_address = "192.168.1.100", ending_address = "192.168.1.110" },
+  ]
+  static_routes = [
+    { destination = "10.0.0.0", subnet_mask = "255.0.0.0", router = "192.168.1.254" },
+  ]
+  vendor_info = [
+    { identifier = "substring(vendor-class-identifier,0,9)==\"PXEClient\"", information = "060101" },
+  ]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "id", "lan"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "starting_address", "192.168.1.10"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "ending_address", "192.168.1.200"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "subnet_mask", "255.255.255.0"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "lease_time_days", "7"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "lease_time_hours", "12"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "router_address", "192.168.1.1"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "dns_servers.#", "2"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "domain_search_list.1", "example.com"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "exclusions.0.ending_address", "192.168.1.110"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "static_routes.0.router", "192.168.1.254"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "vendor_info.0.information", "060101"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "dns_updates", "true"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "dns_ttl", "300"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "enabled", "true"),
+					testAccCheckDhcpScopeOption(server, "lan", "enabled", true),
+					testAccCheckDhcpScopeOption(server, "lan", "ntpServers", []string{"192.168.1.4"}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "technitium_dhcp_scope.test",
+				ImportState:       true,
+				ImportStateId:     "lan",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, options left out of the
+			// configuration are cleared
+			{
+				Config: testAccProviderConfig(server) + testAccDhcpScopeResourceConfig("lan", `
+  dns_servers = ["192.168.1.2"]
+  enabled     = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "dns_servers.#", "1"),
+					resource.TestCheckNoResourceAttr("technitium_dhcp_scope.test", "router_address"),
+					resource.TestCheckNoResourceAttr("technitium_dhcp_scope.test", "exclusions"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "lease_time_hours", "12"),
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "enabled", "false"),
+					testAccCheckDhcpScopeOption(server, "lan", "enabled", false),
+					testAccCheckDhcpScopeOption(server, "lan", "routerAddress", ""),
+					testAccCheckDhcpScopeOption(server, "lan", "exclusions", []map[string]string{}),
+				),
+			},
+			// Changes made outside of Terraform are detected and reverted
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.scopes["lan"].options["dnsServers"] = []string{"192.168.1.9"}
+				},
+				Config: testAccProviderConfig(server) + testAccDhcpScopeResourceConfig("lan", `
+  dns_servers = ["192.168.1.2"]
+  enabled     = false
+`),
+				Check: testAccCheckDhcpScopeOption(server, "lan", "dnsServers", []string{"192.168.1.2"}),
+			},
+			// Renaming keeps the scope
+			{
+				Config: testAccProviderConfig(server) + testAccDhcpScopeResourceConfig("office", `
+  dns_servers = ["192.168.1.2"]
+  enabled     = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dhcp_scope.test", "id", "office"),
+					testAccCheckDhcpScopeOption(server, "office", "leaseTimeHours", 12),
+					testAccCheckDhcpScopeDestroy(server, "lan"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDhcpScopeResource_alreadyExists(t *testing.T) {
+	server := newFakeServer(t)
+	server.scopes["lan"] = &fakeDhcpScope{name: "lan", options: map[string]interface{}{}}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccDhcpScopeResourceConfig("lan", ""),
+				ExpectError: regexp.MustCompile(`a\s+scope\s+with\s+this\s+name\s+already\s+exists`),
+			},
+		},
+	})
+}
+
+func TestAccDhcpScopeResource_invalidAddress(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccDhcpScopeResourceConfig("lan", `router_address = "fd00::1"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value\s+must\s+be\s+an\s+IPv4\s+address`),
+			},
+		},
+	})
+}
+
+func testAccDhcpScopeResourceConfig(name string, options string) string {
+	return fmt.Sprintf(`
+resource "technitium_dhcp_scope" "test" {
+  name             = %q
+  starting_address = "192.168.1.10"
+  ending_address   = "192.168.1.200"
+  subnet_mask      = "255.255.255.0"
+%s
+}
+`, name, options)
+}
+
+// testAccCheckDhcpScopeOption checks an option of a scope as the fake server
+// stores it.
+func testAccCheckDhcpScopeOption(server *fakeServer, name string, key string, expected interface{}) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		options := server.dhcpScopeOptions(name)
+		if options == nil {
+			return fmt.Errorf("dhcp scope %s does not exist on the server", name)
+		}
+		if !reflect.DeepEqual(options[key], expected) {
+			return fmt.Errorf("dhcp scope %s has %s %#v, expected %#v", name, key, options[key], expected)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDhcpScopeDestroy(server *fakeServer, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if server.dhcpScopeOptions(name) != nil {
+			return fmt.Errorf("dhcp scope %s still exists on the server", name)
+		}
+		return nil
+	}
+}
+
+func TestAccDhcpScopeResource_enableFails(t *testing.T) {
+	server := newFakeServer(t)
+	server.dhcpEnableError = "DHCP Server requires static IP address to work correctly."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDhcpScopeDestroy(server, "lan"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccDhcpScopeResourceConfig("lan", ""),
+				ExpectError: regexp.MustCompile(`requires\s+static\s+IP\s+address`),
+			},
+			// The scope was saved and tainted, so it is replaced instead of
+			// failing as an existing scope.
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.dhcpEnableError = ""
+				},
+				Config: testAccProviderConfig(server) + testAccDhcpScopeResourceConfig("lan", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dhcp_scope.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: testAccCheckDhcpScopeOption(server, "lan", "enabled", true),
+			},
+		},
+	})
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsAppConfigResource{}
+	_ resource.ResourceWithConfigure   = &dnsAppConfigResource{}
+	_ resource.ResourceWithImportState = &dnsAppConfigResource{}
+)
+
+// NewDnsAppConfigResource is a helper function to simplify the provider implementation.
+func NewDnsAppConfigResource() resource.Resource {
+	return &dnsAppConfigResource{}
+}
+
+// dnsAppConfigResource manages the JSON config of an installed DNS app. The
+// config read back is compared as JSON, so the server reformatting it does
+// not show as drift. Deleting the resource leaves the config on the server,
+// as there is no config to go back to.
+type dnsAppConfigResource struct {
+	client *technitiumClient
+}
+
+// dnsAppConfigResourceModel maps the resource schema data.
+type dnsAppConfigResourceModel struct {
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Config jsonValue    `tfsdk:"config"`
+}
+
+// Metadata returns the resource type name.
+func (r *dnsAppConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_app_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsAppConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"config": schema.StringAttribute{
+				Required:   true,
+				CustomType: jsonType{},
+				Validators: []validator.String{
+					jsonDocument(),
+				},
+			},
+		},
+	}
+}
+
+// Create sets the app config and sets the initial Terraform state.
+func (r *dnsAppConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsAppConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the app config
+	if err := r.setConfig(ctx, plan); err != nil {
+		addAPIError(&resp.Diagnostics, "create", "dns app config", plan.Name.ValueString(), err)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = plan.Name
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsAppConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsAppConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The config goes away with the app.
+	apps, _, err := r.client.AppsAPI.ListApps(ctx).Execute()
+	if err = checkResponse(apps, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "dns app config", state.Name.ValueString(), err)
+		return
+	}
+	installed := false
+	for _, app := range apps.GetResponse().Apps {
+		if strings.EqualFold(app.GetName(), state.Name.ValueString()) {
+			installed = true
+			break
+		}
+	}
+	if !installed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Get refreshed config from Technitium
+	answ, _, err := r.client.AppsAPI.GetAppConfig(ctx).Name(state.Name.ValueString()).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "dns app config", state.Name.ValueString(), err)
+		return
+	}
+
+	body := answ.GetResponse()
+	state.Config = jsonStringValue(body.GetConfig())
+	state.ID = state.Name
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update sets the app config and sets the updated Terraform state on success.
+func (r *dnsAppConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsAppConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the app config
+	if err := r.setConfig(ctx, plan); err != nil {
+		addAPIError(&resp.Diagnostics, "update", "dns app config", plan.Name.ValueString(), err)
+		return
+	}
+	plan.ID = plan.Name
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete only removes the Terraform state, the config stays on the server
+// until the app is uninstalled.
+func (r *dnsAppConfigResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the config of an installed app by the app name.
+func (r *dnsAppConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsAppConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// setConfig replaces the config of the app with the planned config.
+func (r *dnsAppConfigResource) setConfig(ctx context.Context, plan dnsAppConfigResourceModel) error {
+	set := r.client.AppsAPI.SetAppConfig(ctx)
+	set = set.Name(plan.Name.ValueString())
+	set = set.Config(plan.Config.ValueString())
+	answ, _, err := set.Execute()
+	return checkResponse(answ, err)
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDnsAppConfigResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDnsAppConfigResourceConfig(`{"enableLogging": true, "maxLogDays": 7}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_app_config.test", "id", "Query Logs (Sqlite)"),
+					resource.TestCheckResourceAttr("technitium_dns_app_config.test", "config", `{"enableLogging": true, "maxLogDays": 7}`),
+					testAccCheckDnsAppConfig(server, "Query Logs (Sqlite)", `{"enableLogging": true, "maxLogDays": 7}`),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "technitium_dns_app_config.test",
+				ImportState:       true,
+				ImportStateId:     "Query Logs (Sqlite)",
+				ImportStateVerify: true,
+			},
+			// The server reformatting the config is not drift
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.apps["Query Logs (Sqlite)"].config = "{\"maxLogDays\":7,\"enableLogging\":true}"
+				},
+				Config: testAccProviderConfig(server) + testAccDnsAppConfigResourceConfig(`{"enableLogging": true, "maxLogDays": 7}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// A config changed outside of Terraform is set again
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.apps["Query Logs (Sqlite)"].config = `{"enableLogging": false, "maxLogDays": 7}`
+				},
+				Config: testAccProviderConfig(server) + testAccDnsAppConfigResourceConfig(`{"enableLogging": true, "maxLogDays": 7}`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_app_config.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckDnsAppConfig(server, "Query Logs (Sqlite)", `{"enableLogging": true, "maxLogDays": 7}`),
+			},
+			// Reformatting the config sends it again
+			{
+				Config: testAccProviderConfig(server) + testAccDnsAppConfigResourceConfig("{\n  \"maxLogDays\": 7,\n  \"enableLogging\": true\n}"),
+				Check:  testAccCheckDnsAppConfig(server, "Query Logs (Sqlite)", "{\n  \"maxLogDays\": 7,\n  \"enableLogging\": true\n}"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDnsAppConfigResourceConfig(`{"enableLogging": true, "maxLogDays": 30}`),
+				Check:  testAccCheckDnsAppConfig(server, "Query Logs (Sqlite)", `{"enableLogging": true, "maxLogDays": 30}`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsAppConfigResource_invalidJSON(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccDnsAppConfigResourceConfig(`{"enableLogging": true,}`),
+				ExpectError: regexp.MustCompile(`Invalid\s+JSON\s+Document`),
+			},
+		},
+	})
+}
+
+func testAccDnsAppConfigResourceConfig(config string) string {
+	return fmt.Sprintf(`
+resource "technitium_dns_app" "test" {
+  name = "Query Logs (Sqlite)"
+}
+
+resource "technitium_dns_app_config" "test" {
+  name   = technitium_dns_app.test.name
+  config = %q
+}
+`, config)
+}
+
+func testAccCheckDnsAppConfig(server *fakeServer, name string, expected string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if config := server.appConfig(name); config != expected {
+			return fmt.Errorf("expected config of app %s to be %s, got %s", name, expected, config)
+		}
+		return nil
+	}
+}
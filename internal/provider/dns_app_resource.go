@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsAppResource{}
+	_ resource.ResourceWithConfigure   = &dnsAppResource{}
+	_ resource.ResourceWithImportState = &dnsAppResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsAppResource{}
+)
+
+// NewDnsAppResource is a helper function to simplify the provider implementation.
+func NewDnsAppResource() resource.Resource {
+	return &dnsAppResource{}
+}
+
+// dnsAppResource installs a DNS app, from the app store by default, from a
+// download url or from a local zip file. Store apps are updated in place
+// when the store publishes a new version, and zip files when their content
+// changes.
+type dnsAppResource struct {
+	client *technitiumClient
+}
+
+// dnsAppResourceModel maps the resource schema data.
+type dnsAppResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	URL        types.String `tfsdk:"url"`
+	File       types.String `tfsdk:"file"`
+	FileSHA256 types.String `tfsdk:"file_sha256"`
+	Version    types.String `tfsdk:"version"`
+}
+
+// Metadata returns the resource type name.
+func (r *dnsAppResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_app"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsAppResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					httpURL(),
+					stringvalidator.ConflictsWith(path.MatchRoot("file")),
+				},
+			},
+			"file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"file_sha256": schema.StringAttribute{
+				Computed: true,
+			},
+			"version": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// ModifyPlan works out whether the app needs to be updated: when the store
+// has a newer version, when the download url changes or when the content of
+// the zip file changes.
+func (r *dnsAppResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan dnsAppResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.URL.IsUnknown() || plan.File.IsUnknown() {
+		return
+	}
+	var state *dnsAppResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &dnsAppResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The installed version stays unless the app changes.
+	update := state == nil || !plan.Name.Equal(state.Name)
+	if !plan.File.IsNull() {
+		sum, err := fileSHA256(plan.File.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("file"),
+				"Error Reading DNS App File",
+				fmt.Sprintf("Could not read the DNS app zip file %s: %s", plan.File.ValueString(), err),
+			)
+			return
+		}
+		plan.FileSHA256 = types.StringValue(sum)
+		update = update || !plan.FileSHA256.Equal(state.FileSHA256)
+	} else {
+		plan.FileSHA256 = types.StringNull()
+		if state != nil {
+			update = update || !plan.URL.Equal(state.URL) || !state.File.IsNull()
+		}
+		if plan.URL.IsNull() {
+			storeApp, err := findStoreApp(ctx, r.client, plan.Name.ValueString())
+			switch {
+			case err != nil && update:
+				addAPIError(&resp.Diagnostics, "read", "dns app store", "", err)
+				return
+			case err != nil:
+				// The installed app stays as it is when the store cannot
+				// be reached.
+				resp.Diagnostics.AddWarning(
+					"Unable to Check DNS App Store",
+					fmt.Sprintf("Could not check the app store for a new version of %q, keeping the installed version, %s", plan.Name.ValueString(), describeAPIError(err)),
+				)
+			case storeApp == nil && update:
+				resp.Diagnostics.AddAttributeError(
+					path.Root("name"),
+					"DNS App Not Found in Store",
+					fmt.Sprintf("The app store has no app named %q. Set url or file to install an app that is not in the store.", plan.Name.ValueString()),
+				)
+				return
+			case storeApp != nil && !update && storeApp.GetVersion() != state.Version.ValueString():
+				tflog.Info(ctx, "DNS app store has a new version", map[string]interface{}{
+					"name":      plan.Name.ValueString(),
+					"installed": state.Version.ValueString(),
+					"store":     storeApp.GetVersion(),
+				})
+				update = true
+			}
+		}
+	}
+
+	if update {
+		plan.Version = types.StringUnknown()
+	} else {
+		plan.Version = state.Version
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create installs the app and sets the initial Terraform state.
+func (r *dnsAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsAppResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Install the app
+	app, err := r.install(ctx, plan, false)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "create", "dns app", plan.Name.ValueString(), err)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = plan.Name
+	plan.Version = types.StringValue(app.GetVersion())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsAppResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed app from Technitium
+	answ, _, err := r.client.AppsAPI.ListApps(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "dns app", state.Name.ValueString(), err)
+		return
+	}
+
+	var app *technitium.App
+	apps := answ.GetResponse().Apps
+	for i := range apps {
+		if strings.EqualFold(apps[i].GetName(), state.Name.ValueString()) {
+			app = &apps[i]
+			break
+		}
+	}
+	if app == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.ID = state.Name
+	state.Version = types.StringValue(app.GetVersion())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the app in place and sets the updated Terraform state on
+// success.
+func (r *dnsAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state dnsAppResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The version is only unknown when the app itself changes.
+	if plan.Version.IsUnknown() {
+		app, err := r.install(ctx, plan, true)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "update", "dns app", plan.Name.ValueString(), err)
+			return
+		}
+		plan.Version = types.StringValue(app.GetVersion())
+	}
+	plan.ID = plan.Name
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete uninstalls the app and removes the Terraform state on success.
+func (r *dnsAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsAppResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Uninstall the app
+	answ, _, err := r.client.AppsAPI.UninstallApp(ctx).Name(state.Name.ValueString()).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "delete", "dns app", state.Name.ValueString(), err)
+		return
+	}
+}
+
+// ImportState imports an installed app by its name, as an app of the store.
+func (r *dnsAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsAppResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// install installs the app, or updates the installed app, from the zip file,
+// the download url or the store, and returns the app the server reports.
+func (r *dnsAppResource) install(ctx context.Context, plan dnsAppResourceModel, update bool) (*technitium.App, error) {
+	name := plan.Name.ValueString()
+
+	var (
+		answ *technitium.InstallAppResponse
+		err  error
+	)
+	if !plan.File.IsNull() {
+		file, readErr := os.ReadFile(plan.File.ValueString())
+		if readErr != nil {
+			return nil, readErr
+		}
+		if update {
+			answ, _, err = r.client.AppsAPI.UpdateApp(ctx).Name(name).File(file).Execute()
+		} else {
+			answ, _, err = r.client.AppsAPI.InstallApp(ctx).Name(name).File(file).Execute()
+		}
+	} else {
+		url := plan.URL.ValueString()
+		if plan.URL.IsNull() {
+			storeApp, storeErr := findStoreApp(ctx, r.client, name)
+			if storeErr != nil {
+				return nil, storeErr
+			}
+			if storeApp == nil {
+				return nil, fmt.Errorf("the app store has no app named %q", name)
+			}
+			url = storeApp.GetUrl()
+		}
+		if update {
+			answ, _, err = r.client.AppsAPI.DownloadAndUpdateApp(ctx).Name(name).Url(url).Execute()
+		} else {
+			answ, _, err = r.client.AppsAPI.DownloadAndInstallApp(ctx).Name(name).Url(url).Execute()
+		}
+	}
+	if err = checkResponse(answ, err); err != nil {
+		return nil, err
+	}
+
+	body := answ.GetResponse()
+	if body.UpdatedApp != nil {
+		return body.UpdatedApp, nil
+	}
+	if body.InstalledApp != nil {
+		return body.InstalledApp, nil
+	}
+	return &technitium.App{}, nil
+}
+
+// findStoreApp returns the app of the store with the given name, or nil.
+func findStoreApp(ctx context.Context, client *technitiumClient, name string) (*technitium.StoreApp, error) {
+	answ, _, err := client.AppsAPI.ListStoreApps(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		return nil, err
+	}
+	storeApps := answ.GetResponse().StoreApps
+	for i := range storeApps {
+		if strings.EqualFold(storeApps[i].GetName(), name) {
+			return &storeApps[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// fileSHA256 returns the hex encoded SHA-256 digest of a file.
+func fileSHA256(name string) (string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"archive/zip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDnsAppResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDnsAppVersion(server, "Split Horizon", ""),
+		Steps: []resource.TestStep{
+			// Create and Read testing, from the store
+			{
+				Config: testAccProviderConfig(server) + testAccDnsAppResourceConfig("Split Horizon", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_app.test", "id", "Split Horizon"),
+					resource.TestCheckResourceAttr("technitium_dns_app.test", "version", "8.0"),
+					resource.TestCheckNoResourceAttr("technitium_dns_app.test", "file_sha256"),
+					testAccCheckDnsAppVersion(server, "Split Horizon", "8.0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "technitium_dns_app.test",
+				ImportState:       true,
+				ImportStateId:     "Split Horizon",
+				ImportStateVerify: true,
+			},
+			// A new version in the store updates the app in place
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.store["Split Horizon"] = "9.0"
+				},
+				Config: testAccProviderConfig(server) + testAccDnsAppResourceConfig("Split Horizon", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_app.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_app.test", "version", "9.0"),
+					testAccCheckDnsAppVersion(server, "Split Horizon", "9.0"),
+				),
+			},
+			// A download url installs that download instead
+			{
+				Config: testAccProviderConfig(server) + testAccDnsAppResourceConfig("Split Horizon", `url = "https://example.com/SplitHorizonApp.zip"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_app.test", "version", "1.0"),
+					testAccCheckDnsAppVersion(server, "Split Horizon", "1.0"),
+				),
+			},
+			// An app uninstalled outside of Terraform is installed again
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					delete(server.apps, "Split Horizon")
+				},
+				Config: testAccProviderConfig(server) + testAccDnsAppResourceConfig("Split Horizon", `url = "https://example.com/SplitHorizonApp.zip"`),
+				Check:  testAccCheckDnsAppVersion(server, "Split Horizon", "1.0"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsAppResource_file(t *testing.T) {
+	server := newFakeServer(t)
+	file := filepath.Join(t.TempDir(), "MyApp.zip")
+	writeDnsAppZip(t, file, "1.0")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDnsAppVersion(server, "My App", ""),
+		Steps: []resource.TestStep{
+			// Create and Read testing, from a zip file
+			{
+				Config: testAccProviderConfig(server) + testAccDnsAppResourceConfig("My App", fmt.Sprintf("file = %q", file)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_app.test", "version", "1.0"),
+					resource.TestCheckResourceAttrSet("technitium_dns_app.test", "file_sha256"),
+					testAccCheckDnsAppVersion(server, "My App", "1.0"),
+				),
+			},
+			// A changed zip file updates the app in place
+			{
+				PreConfig: func() { writeDnsAppZip(t, file, "1.1") },
+				Config:    testAccProviderConfig(server) + testAccDnsAppResourceConfig("My App", fmt.Sprintf("file = %q", file)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_app.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_app.test", "version", "1.1"),
+					testAccCheckDnsAppVersion(server, "My App", "1.1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsAppResource_storeUnreachable(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDnsAppVersion(server, "Split Horizon", ""),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccDnsAppResourceConfig("Split Horizon", ""),
+			},
+			// The installed app is kept when the store cannot be reached
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.storeError = "Failed to download the app store listing."
+				},
+				Config: testAccProviderConfig(server) + testAccDnsAppResourceConfig("Split Horizon", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("technitium_dns_app.test", "version", "8.0"),
+			},
+		},
+	})
+}
+
+func TestAccDnsAppResource_invalidURL(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccDnsAppResourceConfig("Split Horizon", `url = "ftp://example.com/SplitHorizonApp.zip"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must\s+be\s+an\s+http\s+or\s+https\s+URL`),
+			},
+		},
+	})
+}
+
+func TestAccDnsAppResource_notInStore(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccDnsAppResourceConfig("No Such App", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The\s+app\s+store\s+has\s+no\s+app\s+named\s+"No\s+Such\s+App"`),
+			},
+		},
+	})
+}
+
+func testAccDnsAppResourceConfig(name string, options string) string {
+	return fmt.Sprintf(`
+resource "technitium_dns_app" "test" {
+  name = %q
+  %s
+}
+`, name, options)
+}
+
+func testAccCheckDnsAppVersion(server *fakeServer, name string, expected string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if version := server.appVersion(name); version != expected {
+			return fmt.Errorf("dns app %s has version %q, expected %q", name, version, expected)
+		}
+		return nil
+	}
+}
+
+// writeDnsAppZip writes an app zip file the fake server reads the version
+// from.
+func writeDnsAppZip(t *testing.T, name string, version string) {
+	t.Helper()
+
+	file, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	archive := zip.NewWriter(file)
+	entry, err := archive.Create("version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := entry.Write([]byte(version)); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -3,18 +3,24 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net"
+	"strings"
 	"terraform-provider-technitium/internal/provider/technitium"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &dnsRecordResource{}
-	_ resource.ResourceWithConfigure = &dnsRecordResource{}
+	_ resource.Resource                = &dnsRecordResource{}
+	_ resource.ResourceWithConfigure   = &dnsRecordResource{}
+	_ resource.ResourceWithImportState = &dnsRecordResource{}
 )
 
 // NewDnsRecordResource is a helper function to simplify the provider implementation.
@@ -25,7 +31,7 @@ func NewDnsRecordResource() resource.Resource {
 // dnsRecordResource is the resource implementation.
 
 type dnsRecordResource struct {
-	client *technitium.APIClient
+	client *technitiumClient
 }
 
 // orderResourceModel maps the resource schema data.
@@ -55,6 +61,9 @@ func (r *dnsRecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"zone": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"domain": schema.StringAttribute{
 				Required: true,
@@ -64,6 +73,9 @@ func (r *dnsRecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"type": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"ttl": schema.Int32Attribute{
 				Optional: true,
@@ -112,27 +124,13 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	answ, _, err := record.Execute()
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating dns record",
-			"Could not create dns record, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	if answ.GetStatus() != "ok" {
-		resp.Diagnostics.AddError(
-			"Error creating dns record",
-			"Could not create dns record, unexpected error: "+answ.GetErrorMessage(),
-		)
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "create", "dns record", plan.Domain.ValueString(), err)
 		return
 	}
 
 	// Map response body to schema and populate Computed attribute values
-	fqdn := plan.Domain.ValueString() + "." + plan.Zone.ValueString()
-	ID := GetMD5Hash(fqdn)
-	plan.ID = types.StringValue(ID)
+	plan.ID = types.StringValue(plan.importID())
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
 	// Set state to fully populated data
@@ -145,6 +143,53 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 
 // Read refreshes the Terraform state with the latest data.
 func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsRecordResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed records of the domain from Technitium
+	records := r.client.DnsRecordAPI.GetDnsRecords(ctx)
+	records = records.Zone(state.Zone.ValueString())
+	records = records.Domain(state.Domain.ValueString())
+	answ, _, err := records.Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "dns record", state.Domain.ValueString(), err)
+		return
+	}
+
+	var record *technitium.DnsRecord
+	for i := range answ.Response.Records {
+		candidate := &answ.Response.Records[i]
+		if strings.EqualFold(candidate.GetType(), state.Type.ValueString()) &&
+			sameIPAddress(candidate.RData.GetIpAddress(), state.IPAddress.ValueString()) {
+			record = candidate
+			break
+		}
+	}
+
+	// The record was deleted outside of Terraform.
+	if record == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// The TTL is only tracked when configured, or when the record was just
+	// imported and nothing is known about the configuration yet.
+	if !state.Ttl.IsNull() || state.ID.IsNull() {
+		state.Ttl = types.Int32Value(record.GetTtl())
+	}
+	state.ID = types.StringValue(state.importID())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -177,26 +222,12 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Update existing dns record
 	answ, _, err := record.Execute()
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating dns record",
-			"Could not update dns record, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	if answ.GetStatus() != "ok" {
-		resp.Diagnostics.AddError(
-			"Error updating dns record",
-			"Could not create dns record, unexpected error: "+answ.GetErrorMessage(),
-		)
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "update", "dns record", plan.Domain.ValueString(), err)
 		return
 	}
 
-	fqdn := plan.Domain.ValueString() + "." + plan.Zone.ValueString()
-	ID := GetMD5Hash(fqdn)
-	plan.ID = types.StringValue(ID)
+	plan.ID = types.StringValue(plan.importID())
 	// // Update resource state with updated items and timestamp
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
@@ -219,26 +250,50 @@ func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	// Delete existing dns record
 	record := r.client.DnsRecordAPI.DeleteDnsRecord(ctx)
+	record = record.Zone(state.Zone.ValueString())
 	record = record.Domain(state.Domain.ValueString())
 	record = record.Type_(state.Type.ValueString())
 	record = record.IpAddress(state.IPAddress.ValueString())
 	answ, _, err := record.Execute()
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting dns record",
-			"Could not delete dns record, unexpected error: "+err.Error(),
-		)
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "delete", "dns record", state.Domain.ValueString(), err)
 		return
 	}
+}
 
-	if answ.GetStatus() != "ok" {
+// ImportState imports an existing record. The import ID has the form
+// zone:domain:type:ip_address, e.g. example.com:www.example.com:A:192.0.2.1.
+func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The IP address comes last as IPv6 addresses contain colons.
+	parts := strings.SplitN(req.ID, ":", 4)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
 		resp.Diagnostics.AddError(
-			"Error deleting dns record",
-			"Could not create dns record, unexpected error: "+answ.GetErrorMessage(),
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: zone:domain:type:ip_address. Got: %q", req.ID),
 		)
 		return
 	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_address"), parts[3])...)
+}
+
+// importID returns the identifier the record is imported with, which also
+// serves as its ID.
+func (m dnsRecordResourceModel) importID() string {
+	return strings.Join([]string{m.Zone.ValueString(), m.Domain.ValueString(), m.Type.ValueString(), m.IPAddress.ValueString()}, ":")
+}
+
+// sameIPAddress reports whether both strings are the same IP address,
+// ignoring differences in notation such as IPv6 zero compression.
+func sameIPAddress(a string, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	if ipA == nil || ipB == nil {
+		return a == b
+	}
+	return ipA.Equal(ipB)
 }
 
 // Configure adds the provider configured client to the resource.
@@ -249,12 +304,12 @@ func (r *dnsRecordResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*technitium.APIClient)
+	client, ok := req.ProviderData.(*technitiumClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *technitiumclient.TechnitiumDNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDnsRecordResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDnsZoneDestroy(server, "example.com"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDnsRecordResourceConfig("A", "192.0.2.1", 300),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_record.test", "zone", "example.com"),
+					resource.TestCheckResourceAttr("technitium_dns_record.test", "domain", "www.example.com"),
+					resource.TestCheckResourceAttr("technitium_dns_record.test", "type", "A"),
+					resource.TestCheckResourceAttr("technitium_dns_record.test", "ip_address", "192.0.2.1"),
+					resource.TestCheckResourceAttr("technitium_dns_record.test", "ttl", "300"),
+					resource.TestCheckResourceAttr("technitium_dns_record.test", "id", "example.com:www.example.com:A:192.0.2.1"),
+					testAccCheckDnsRecordExists(server, "www.example.com", "A", "192.0.2.1", 300),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "technitium_dns_record.test",
+				ImportState:             true,
+				ImportStateId:           "example.com:www.example.com:A:192.0.2.1",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDnsRecordResourceConfig("A", "192.0.2.2", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_record.test", "ip_address", "192.0.2.2"),
+					resource.TestCheckResourceAttr("technitium_dns_record.test", "ttl", "600"),
+					testAccCheckDnsRecordExists(server, "www.example.com", "A", "192.0.2.2", 600),
+					testAccCheckDnsRecordDestroy(server, "www.example.com", "A", "192.0.2.1"),
+				),
+			},
+			// Changing the type replaces the record
+			{
+				Config: testAccProviderConfig(server) + testAccDnsRecordResourceConfig("AAAA", "2001:db8::1", 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDnsRecordExists(server, "www.example.com", "AAAA", "2001:db8::1", 600),
+					testAccCheckDnsRecordDestroy(server, "www.example.com", "A", "192.0.2.2"),
+				),
+			},
+			// IPv6 addresses can be imported
+			{
+				ResourceName:            "technitium_dns_record.test",
+				ImportState:             true,
+				ImportStateId:           "example.com:www.example.com:AAAA:2001:db8::1",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// A record deleted outside of Terraform is created again
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.zones["example.com"].removeRecords(func(record *fakeRecord) bool {
+						return record.rrType == "AAAA"
+					})
+				},
+				Config: testAccProviderConfig(server) + testAccDnsRecordResourceConfig("AAAA", "2001:db8::1", 600),
+				Check:  testAccCheckDnsRecordExists(server, "www.example.com", "AAAA", "2001:db8::1", 600),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsRecordResource_invalidAddress(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccDnsRecordResourceConfig("A", "2001:db8::1", 300),
+				ExpectError: regexp.MustCompile(`An\s+invalid\s+IP\s+address\s+was\s+specified`),
+			},
+		},
+	})
+}
+
+func testAccDnsRecordResourceConfig(recordType string, ipAddress string, ttl int) string {
+	return fmt.Sprintf(`
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_record" "test" {
+  zone       = technitium_dns_zone.test.name
+  domain     = "www.example.com"
+  type       = %q
+  ip_address = %q
+  ttl        = %d
+}
+`, recordType, ipAddress, ttl)
+}
+
+func testAccCheckDnsRecordExists(server *fakeServer, domain string, recordType string, ipAddress string, ttl int64) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		record := testAccFakeRecord(server, domain, recordType, ipAddress)
+		if record == nil {
+			return fmt.Errorf("%s record %s %s does not exist on the server", recordType, domain, ipAddress)
+		}
+		if record.ttl != ttl {
+			return fmt.Errorf("%s record %s %s has TTL %d, expected %d", recordType, domain, ipAddress, record.ttl, ttl)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDnsRecordDestroy(server *fakeServer, domain string, recordType string, ipAddress string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if testAccFakeRecord(server, domain, recordType, ipAddress) != nil {
+			return fmt.Errorf("%s record %s %s still exists on the server", recordType, domain, ipAddress)
+		}
+		return nil
+	}
+}
+
+func testAccFakeRecord(server *fakeServer, domain string, recordType string, ipAddress string) *fakeRecord {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	for _, zone := range server.zones {
+		for _, record := range zone.recordsAt(domain) {
+			if record.rrType == recordType && sameIPAddress(fmt.Sprint(record.rData["ipAddress"]), ipAddress) {
+				return record
+			}
+		}
+	}
+	return nil
+}
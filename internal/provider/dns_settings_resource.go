@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &dnsSettingsResource{}
+	_ resource.ResourceWithConfigure = &dnsSettingsResource{}
+)
+
+// NewDnsSettingsResource is a helper function to simplify the provider implementation.
+func NewDnsSettingsResource() resource.Resource {
+	return &dnsSettingsResource{
+		settingsResource: settingsResource[dnsSettingsResourceModel]{
+			id:      "dns_settings",
+			name:    "dns settings",
+			set:     setDnsSettings,
+			refresh: refreshDnsSettings,
+		},
+	}
+}
+
+// dnsSettingsResource manages the general DNS server settings. Recursion,
+// cache, blocking and forwarder settings have their own resources, and the
+// web service settings are left out because changing them can cut the
+// provider off from the server.
+type dnsSettingsResource struct {
+	settingsResource[dnsSettingsResourceModel]
+}
+
+// dnsSettingsResourceModel maps the resource schema data.
+type dnsSettingsResourceModel struct {
+	ID                               types.String `tfsdk:"id"`
+	DnsServerDomain                  types.String `tfsdk:"dns_server_domain"`
+	DnsServerLocalEndPoints          types.List   `tfsdk:"dns_server_local_endpoints"`
+	DnsServerIPv4SourceAddresses     types.List   `tfsdk:"dns_server_ipv4_source_addresses"`
+	DnsServerIPv6SourceAddresses     types.List   `tfsdk:"dns_server_ipv6_source_addresses"`
+	DefaultRecordTtl                 types.Int32  `tfsdk:"default_record_ttl"`
+	DefaultResponsiblePerson         types.String `tfsdk:"default_responsible_person"`
+	UseSoaSerialDateScheme           types.Bool   `tfsdk:"use_soa_serial_date_scheme"`
+	MinSoaRefresh                    types.Int32  `tfsdk:"min_soa_refresh"`
+	MinSoaRetry                      types.Int32  `tfsdk:"min_soa_retry"`
+	ZoneTransferAllowedNetworks      types.List   `tfsdk:"zone_transfer_allowed_networks"`
+	NotifyAllowedNetworks            types.List   `tfsdk:"notify_allowed_networks"`
+	DnsAppsEnableAutomaticUpdate     types.Bool   `tfsdk:"dns_apps_enable_automatic_update"`
+	PreferIPv6                       types.Bool   `tfsdk:"prefer_ipv6"`
+	EnableUdpSocketPool              types.Bool   `tfsdk:"enable_udp_socket_pool"`
+	UdpPayloadSize                   types.Int32  `tfsdk:"udp_payload_size"`
+	DnssecValidation                 types.Bool   `tfsdk:"dnssec_validation"`
+	EDnsClientSubnet                 types.Bool   `tfsdk:"edns_client_subnet"`
+	EDnsClientSubnetIPv4PrefixLength types.Int32  `tfsdk:"edns_client_subnet_ipv4_prefix_length"`
+	EDnsClientSubnetIPv6PrefixLength types.Int32  `tfsdk:"edns_client_subnet_ipv6_prefix_length"`
+	QpmLimitRequests                 types.Int32  `tfsdk:"qpm_limit_requests"`
+	QpmLimitErrors                   types.Int32  `tfsdk:"qpm_limit_errors"`
+	QpmLimitSampleMinutes            types.Int32  `tfsdk:"qpm_limit_sample_minutes"`
+	QpmLimitIPv4PrefixLength         types.Int32  `tfsdk:"qpm_limit_ipv4_prefix_length"`
+	QpmLimitIPv6PrefixLength         types.Int32  `tfsdk:"qpm_limit_ipv6_prefix_length"`
+	QpmLimitBypassList               types.List   `tfsdk:"qpm_limit_bypass_list"`
+	ClientTimeout                    types.Int32  `tfsdk:"client_timeout"`
+	TcpSendTimeout                   types.Int32  `tfsdk:"tcp_send_timeout"`
+	TcpReceiveTimeout                types.Int32  `tfsdk:"tcp_receive_timeout"`
+	ListenBacklog                    types.Int32  `tfsdk:"listen_backlog"`
+	MaxConcurrentResolutionsPerCore  types.Int32  `tfsdk:"max_concurrent_resolutions_per_core"`
+	EnableLogging                    types.Bool   `tfsdk:"enable_logging"`
+	LogQueries                       types.Bool   `tfsdk:"log_queries"`
+	UseLocalTime                     types.Bool   `tfsdk:"use_local_time"`
+	MaxLogFileDays                   types.Int32  `tfsdk:"max_log_file_days"`
+	EnableInMemoryStats              types.Bool   `tfsdk:"enable_in_memory_stats"`
+	MaxStatFileDays                  types.Int32  `tfsdk:"max_stat_file_days"`
+}
+
+// Metadata returns the resource type name.
+func (r *dnsSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dns_server_domain":                     settingString(),
+			"dns_server_local_endpoints":            settingList(),
+			"dns_server_ipv4_source_addresses":      settingList(),
+			"dns_server_ipv6_source_addresses":      settingList(),
+			"default_record_ttl":                    settingInt32(int32validator.AtLeast(0)),
+			"default_responsible_person":            settingString(),
+			"use_soa_serial_date_scheme":            settingBool(),
+			"min_soa_refresh":                       settingInt32(int32validator.AtLeast(0)),
+			"min_soa_retry":                         settingInt32(int32validator.AtLeast(0)),
+			"zone_transfer_allowed_networks":        settingList(),
+			"notify_allowed_networks":               settingList(),
+			"dns_apps_enable_automatic_update":      settingBool(),
+			"prefer_ipv6":                           settingBool(),
+			"enable_udp_socket_pool":                settingBool(),
+			"udp_payload_size":                      settingInt32(int32validator.Between(512, 4096)),
+			"dnssec_validation":                     settingBool(),
+			"edns_client_subnet":                    settingBool(),
+			"edns_client_subnet_ipv4_prefix_length": settingInt32(int32validator.Between(0, 32)),
+			"edns_client_subnet_ipv6_prefix_length": settingInt32(int32validator.Between(0, 64)),
+			"qpm_limit_requests":                    settingInt32(int32validator.AtLeast(0)),
+			"qpm_limit_errors":                      settingInt32(int32validator.AtLeast(0)),
+			"qpm_limit_sample_minutes":              settingInt32(int32validator.AtLeast(0)),
+			"qpm_limit_ipv4_prefix_length":          settingInt32(int32validator.Between(0, 32)),
+			"qpm_limit_ipv6_prefix_length":          settingInt32(int32validator.Between(0, 64)),
+			"qpm_limit_bypass_list":                 settingList(),
+			"client_timeout":                        settingInt32(int32validator.AtLeast(0)),
+			"tcp_send_timeout":                      settingInt32(int32validator.AtLeast(0)),
+			"tcp_receive_timeout":                   settingInt32(int32validator.AtLeast(0)),
+			"listen_backlog":                        settingInt32(int32validator.AtLeast(0)),
+			"max_concurrent_resolutions_per_core":   settingInt32(int32validator.AtLeast(0)),
+			"enable_logging":                        settingBool(),
+			"log_queries":                           settingBool(),
+			"use_local_time":                        settingBool(),
+			"max_log_file_days":                     settingInt32(int32validator.AtLeast(0)),
+			"enable_in_memory_stats":                settingBool(),
+			"max_stat_file_days":                    settingInt32(int32validator.AtLeast(0)),
+		},
+	}
+}
+
+// setDnsSettings adds the declared dns settings to a set settings request.
+func setDnsSettings(ctx context.Context, settings technitium.ApiSetSettingsRequest, plan dnsSettingsResourceModel, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+	if !plan.DnsServerDomain.IsNull() {
+		settings = settings.DnsServerDomain(plan.DnsServerDomain.ValueString())
+	}
+	if !plan.DnsServerLocalEndPoints.IsNull() {
+		settings = settings.DnsServerLocalEndPoints(stringListElements(ctx, plan.DnsServerLocalEndPoints, diags))
+	}
+	if !plan.DnsServerIPv4SourceAddresses.IsNull() {
+		settings = settings.DnsServerIPv4SourceAddresses(stringListElements(ctx, plan.DnsServerIPv4SourceAddresses, diags))
+	}
+	if !plan.DnsServerIPv6SourceAddresses.IsNull() {
+		settings = settings.DnsServerIPv6SourceAddresses(stringListElements(ctx, plan.DnsServerIPv6SourceAddresses, diags))
+	}
+	if !plan.DefaultRecordTtl.IsNull() {
+		settings = settings.DefaultRecordTtl(plan.DefaultRecordTtl.ValueInt32())
+	}
+	if !plan.DefaultResponsiblePerson.IsNull() {
+		settings = settings.DefaultResponsiblePerson(plan.DefaultResponsiblePerson.ValueString())
+	}
+	if !plan.UseSoaSerialDateScheme.IsNull() {
+		settings = settings.UseSoaSerialDateScheme(plan.UseSoaSerialDateScheme.ValueBool())
+	}
+	if !plan.MinSoaRefresh.IsNull() {
+		settings = settings.MinSoaRefresh(plan.MinSoaRefresh.ValueInt32())
+	}
+	if !plan.MinSoaRetry.IsNull() {
+		settings = settings.MinSoaRetry(plan.MinSoaRetry.ValueInt32())
+	}
+	if !plan.ZoneTransferAllowedNetworks.IsNull() {
+		settings = settings.ZoneTransferAllowedNetworks(stringListElements(ctx, plan.ZoneTransferAllowedNetworks, diags))
+	}
+	if !plan.NotifyAllowedNetworks.IsNull() {
+		settings = settings.NotifyAllowedNetworks(stringListElements(ctx, plan.NotifyAllowedNetworks, diags))
+	}
+	if !plan.DnsAppsEnableAutomaticUpdate.IsNull() {
+		settings = settings.DnsAppsEnableAutomaticUpdate(plan.DnsAppsEnableAutomaticUpdate.ValueBool())
+	}
+	if !plan.PreferIPv6.IsNull() {
+		settings = settings.PreferIPv6(plan.PreferIPv6.ValueBool())
+	}
+	if !plan.EnableUdpSocketPool.IsNull() {
+		settings = settings.EnableUdpSocketPool(plan.EnableUdpSocketPool.ValueBool())
+	}
+	if !plan.UdpPayloadSize.IsNull() {
+		settings = settings.UdpPayloadSize(plan.UdpPayloadSize.ValueInt32())
+	}
+	if !plan.DnssecValidation.IsNull() {
+		settings = settings.DnssecValidation(plan.DnssecValidation.ValueBool())
+	}
+	if !plan.EDnsClientSubnet.IsNull() {
+		settings = settings.EDnsClientSubnet(plan.EDnsClientSubnet.ValueBool())
+	}
+	if !plan.EDnsClientSubnetIPv4PrefixLength.IsNull() {
+		settings = settings.EDnsClientSubnetIPv4PrefixLength(plan.EDnsClientSubnetIPv4PrefixLength.ValueInt32())
+	}
+	if !plan.EDnsClientSubnetIPv6PrefixLength.IsNull() {
+		settings = settings.EDnsClientSubnetIPv6PrefixLength(plan.EDnsClientSubnetIPv6PrefixLength.ValueInt32())
+	}
+	if !plan.QpmLimitRequests.IsNull() {
+		settings = settings.QpmLimitRequests(plan.QpmLimitRequests.ValueInt32())
+	}
+	if !plan.QpmLimitErrors.IsNull() {
+		settings = settings.QpmLimitErrors(plan.QpmLimitErrors.ValueInt32())
+	}
+	if !plan.QpmLimitSampleMinutes.IsNull() {
+		settings = settings.QpmLimitSampleMinutes(plan.QpmLimitSampleMinutes.ValueInt32())
+	}
+	if !plan.QpmLimitIPv4PrefixLength.IsNull() {
+		settings = settings.QpmLimitIPv4PrefixLength(plan.QpmLimitIPv4PrefixLength.ValueInt32())
+	}
+	if !plan.QpmLimitIPv6PrefixLength.IsNull() {
+		settings = settings.QpmLimitIPv6PrefixLength(plan.QpmLimitIPv6PrefixLength.ValueInt32())
+	}
+	if !plan.QpmLimitBypassList.IsNull() {
+		settings = settings.QpmLimitBypassList(stringListElements(ctx, plan.QpmLimitBypassList, diags))
+	}
+	if !plan.ClientTimeout.IsNull() {
+		settings = settings.ClientTimeout(plan.ClientTimeout.ValueInt32())
+	}
+	if !plan.TcpSendTimeout.IsNull() {
+		settings = settings.TcpSendTimeout(plan.TcpSendTimeout.ValueInt32())
+	}
+	if !plan.TcpReceiveTimeout.IsNull() {
+		settings = settings.TcpReceiveTimeout(plan.TcpReceiveTimeout.ValueInt32())
+	}
+	if !plan.ListenBacklog.IsNull() {
+		settings = settings.ListenBacklog(plan.ListenBacklog.ValueInt32())
+	}
+	if !plan.MaxConcurrentResolutionsPerCore.IsNull() {
+		settings = settings.MaxConcurrentResolutionsPerCore(plan.MaxConcurrentResolutionsPerCore.ValueInt32())
+	}
+	if !plan.EnableLogging.IsNull() {
+		settings = settings.EnableLogging(plan.EnableLogging.ValueBool())
+	}
+	if !plan.LogQueries.IsNull() {
+		settings = settings.LogQueries(plan.LogQueries.ValueBool())
+	}
+	if !plan.UseLocalTime.IsNull() {
+		settings = settings.UseLocalTime(plan.UseLocalTime.ValueBool())
+	}
+	if !plan.MaxLogFileDays.IsNull() {
+		settings = settings.MaxLogFileDays(plan.MaxLogFileDays.ValueInt32())
+	}
+	if !plan.EnableInMemoryStats.IsNull() {
+		settings = settings.EnableInMemoryStats(plan.EnableInMemoryStats.ValueBool())
+	}
+	if !plan.MaxStatFileDays.IsNull() {
+		settings = settings.MaxStatFileDays(plan.MaxStatFileDays.ValueInt32())
+	}
+	return settings
+}
+
+// refreshDnsSettings copies the managed settings from the server.
+func refreshDnsSettings(ctx context.Context, model *dnsSettingsResourceModel, settings *technitium.DnsSettings, diags *diag.Diagnostics) {
+	model.DnsServerDomain = refreshString(model.DnsServerDomain, settings.DnsServerDomain)
+	model.DnsServerLocalEndPoints = refreshList(ctx, model.DnsServerLocalEndPoints, settings.DnsServerLocalEndPoints, diags)
+	model.DnsServerIPv4SourceAddresses = refreshList(ctx, model.DnsServerIPv4SourceAddresses, settings.DnsServerIPv4SourceAddresses, diags)
+	model.DnsServerIPv6SourceAddresses = refreshList(ctx, model.DnsServerIPv6SourceAddresses, settings.DnsServerIPv6SourceAddresses, diags)
+	model.DefaultRecordTtl = refreshInt32(model.DefaultRecordTtl, settings.DefaultRecordTtl)
+	model.DefaultResponsiblePerson = refreshString(model.DefaultResponsiblePerson, settings.DefaultResponsiblePerson)
+	model.UseSoaSerialDateScheme = refreshBool(model.UseSoaSerialDateScheme, settings.UseSoaSerialDateScheme)
+	model.MinSoaRefresh = refreshInt32(model.MinSoaRefresh, settings.MinSoaRefresh)
+	model.MinSoaRetry = refreshInt32(model.MinSoaRetry, settings.MinSoaRetry)
+	model.ZoneTransferAllowedNetworks = refreshList(ctx, model.ZoneTransferAllowedNetworks, settings.ZoneTransferAllowedNetworks, diags)
+	model.NotifyAllowedNetworks = refreshList(ctx, model.NotifyAllowedNetworks, settings.NotifyAllowedNetworks, diags)
+	model.DnsAppsEnableAutomaticUpdate = refreshBool(model.DnsAppsEnableAutomaticUpdate, settings.DnsAppsEnableAutomaticUpdate)
+	model.PreferIPv6 = refreshBool(model.PreferIPv6, settings.PreferIPv6)
+	model.EnableUdpSocketPool = refreshBool(model.EnableUdpSocketPool, settings.EnableUdpSocketPool)
+	model.UdpPayloadSize = refreshInt32(model.UdpPayloadSize, settings.UdpPayloadSize)
+	model.DnssecValidation = refreshBool(model.DnssecValidation, settings.DnssecValidation)
+	model.EDnsClientSubnet = refreshBool(model.EDnsClientSubnet, settings.EDnsClientSubnet)
+	model.EDnsClientSubnetIPv4PrefixLength = refreshInt32(model.EDnsClientSubnetIPv4PrefixLength, settings.EDnsClientSubnetIPv4PrefixLength)
+	model.EDnsClientSubnetIPv6PrefixLength = refreshInt32(model.EDnsClientSubnetIPv6PrefixLength, settings.EDnsClientSubnetIPv6PrefixLength)
+	model.QpmLimitRequests = refreshInt32(model.QpmLimitRequests, settings.QpmLimitRequests)
+	model.QpmLimitErrors = refreshInt32(model.QpmLimitErrors, settings.QpmLimitErrors)
+	model.QpmLimitSampleMinutes = refreshInt32(model.QpmLimitSampleMinutes, settings.QpmLimitSampleMinutes)
+	model.QpmLimitIPv4PrefixLength = refreshInt32(model.QpmLimitIPv4PrefixLength, settings.QpmLimitIPv4PrefixLength)
+	model.QpmLimitIPv6PrefixLength = refreshInt32(model.QpmLimitIPv6PrefixLength, settings.QpmLimitIPv6PrefixLength)
+	model.QpmLimitBypassList = refreshList(ctx, model.QpmLimitBypassList, settings.QpmLimitBypassList, diags)
+	model.ClientTimeout = refreshInt32(model.ClientTimeout, settings.ClientTimeout)
+	model.TcpSendTimeout = refreshInt32(model.TcpSendTimeout, settings.TcpSendTimeout)
+	model.TcpReceiveTimeout = refreshInt32(model.TcpReceiveTimeout, settings.TcpReceiveTimeout)
+	model.ListenBacklog = refreshInt32(model.ListenBacklog, settings.ListenBacklog)
+	model.MaxConcurrentResolutionsPerCore = refreshInt32(model.MaxConcurrentResolutionsPerCore, settings.MaxConcurrentResolutionsPerCore)
+	model.EnableLogging = refreshBool(model.EnableLogging, settings.EnableLogging)
+	model.LogQueries = refreshBool(model.LogQueries, settings.LogQueries)
+	model.UseLocalTime = refreshBool(model.UseLocalTime, settings.UseLocalTime)
+	model.MaxLogFileDays = refreshInt32(model.MaxLogFileDays, settings.MaxLogFileDays)
+	model.EnableInMemoryStats = refreshBool(model.EnableInMemoryStats, settings.EnableInMemoryStats)
+	model.MaxStatFileDays = refreshInt32(model.MaxStatFileDays, settings.MaxStatFileDays)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDnsSettingsResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDnsSettingsResourceConfig(`
+  dns_server_domain          = "ns1.example.com"
+  default_record_ttl         = 600
+  notify_allowed_networks    = ["10.0.0.0/8"]
+  qpm_limit_requests         = 0
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_settings.test", "id", "dns_settings"),
+					resource.TestCheckResourceAttr("technitium_dns_settings.test", "dns_server_domain", "ns1.example.com"),
+					resource.TestCheckResourceAttr("technitium_dns_settings.test", "default_record_ttl", "600"),
+					resource.TestCheckResourceAttr("technitium_dns_settings.test", "notify_allowed_networks.#", "1"),
+					resource.TestCheckResourceAttr("technitium_dns_settings.test", "qpm_limit_requests", "0"),
+					resource.TestCheckNoResourceAttr("technitium_dns_settings.test", "dnssec_validation"),
+					testAccCheckDnsSetting(server, "dnsServerDomain", "ns1.example.com"),
+					testAccCheckDnsSetting(server, "defaultRecordTtl", 600),
+					testAccCheckDnsSetting(server, "notifyAllowedNetworks", []string{"10.0.0.0/8"}),
+					// Settings that are not declared are left untouched
+					testAccCheckDnsSetting(server, "dnssecValidation", true),
+					testAccCheckDnsSetting(server, "recursion", "AllowOnlyForPrivateNetworks"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDnsSettingsResourceConfig(`
+  dns_server_domain          = "ns1.example.com"
+  default_record_ttl         = 3600
+  notify_allowed_networks    = []
+  dnssec_validation          = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_settings.test", "default_record_ttl", "3600"),
+					resource.TestCheckResourceAttr("technitium_dns_settings.test", "notify_allowed_networks.#", "0"),
+					resource.TestCheckResourceAttr("technitium_dns_settings.test", "dnssec_validation", "false"),
+					resource.TestCheckNoResourceAttr("technitium_dns_settings.test", "qpm_limit_requests"),
+					testAccCheckDnsSetting(server, "defaultRecordTtl", 3600),
+					testAccCheckDnsSetting(server, "notifyAllowedNetworks", []string{}),
+					testAccCheckDnsSetting(server, "dnssecValidation", false),
+					// A setting no longer declared keeps its last value
+					testAccCheckDnsSetting(server, "qpmLimitRequests", 0),
+				),
+			},
+			// A setting changed outside of Terraform is detected and reverted,
+			// undeclared settings changed outside of Terraform are ignored
+			testAccSettingsDriftStep(server, testAccProviderConfig(server)+testAccDnsSettingsResourceConfig(`
+  dns_server_domain          = "ns1.example.com"
+  default_record_ttl         = 3600
+  notify_allowed_networks    = []
+  dnssec_validation          = false
+`), []settingDrift{
+				{key: "defaultRecordTtl", drifted: 60, want: 3600},
+				{key: "recursion", drifted: "Deny", want: "Deny"},
+			}),
+			// Delete testing automatically occurs in TestCase
+		},
+		// Destroying the resource leaves the settings as they are
+		CheckDestroy: testAccCheckDnsSetting(server, "defaultRecordTtl", 3600),
+	})
+}
+
+func testAccDnsSettingsResourceConfig(settings string) string {
+	return fmt.Sprintf(`
+resource "technitium_dns_settings" "test" {%s}
+`, settings)
+}
+
+func testAccCheckDnsSetting(server *fakeServer, key string, expected interface{}) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if value := server.setting(key); !reflect.DeepEqual(value, expected) {
+			return fmt.Errorf("setting %s is %#v, expected %#v", key, value, expected)
+		}
+		return nil
+	}
+}
+
+// settingDrift is a setting changed on the server outside of Terraform, and
+// the value it must have once the configuration is applied again.
+type settingDrift struct {
+	key     string
+	drifted interface{}
+	want    interface{}
+}
+
+// testAccSettingsDriftStep changes the settings on the server, applies
+// config again and checks the value of each setting afterwards, along with
+// any further checks.
+func testAccSettingsDriftStep(server *fakeServer, config string, drifts []settingDrift, checks ...resource.TestCheckFunc) resource.TestStep {
+	for _, drift := range drifts {
+		checks = append(checks, testAccCheckDnsSetting(server, drift.key, drift.want))
+	}
+	return resource.TestStep{
+		PreConfig: func() {
+			server.mu.Lock()
+			defer server.mu.Unlock()
+			for _, drift := range drifts {
+				server.settings[drift.key] = drift.drifted
+			}
+		},
+		Config: config,
+		Check:  resource.ComposeAggregateTestCheckFunc(checks...),
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"terraform-provider-technitium/internal/provider/technitium"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -33,7 +32,7 @@ type dnsZoneModel struct {
 }
 
 type dnsZonesDataSource struct {
-	client *technitium.APIClient
+	client *technitiumClient
 }
 
 func NewDnsZonesDataSource() datasource.DataSource {
@@ -89,19 +88,8 @@ func (d *dnsZonesDataSource) Read(ctx context.Context, req datasource.ReadReques
 	)
 
 	answ, _, err := d.client.DnsZoneAPI.ListDnsZones(ctx).Execute()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read HashiCups Coffees",
-			err.Error(),
-		)
-		return
-	}
-
-	if answ.GetStatus() != "ok" {
-		resp.Diagnostics.AddError(
-			"Error deleting dns record",
-			"Could not create dns record, unexpected error: "+answ.GetErrorMessage(),
-		)
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "list", "dns zones", "", err)
 		return
 	}
 
@@ -147,11 +135,11 @@ func (d *dnsZonesDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*technitium.APIClient)
+	client, ok := req.ProviderData.(*technitiumClient)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *technitiumclient.TechnitiumDNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
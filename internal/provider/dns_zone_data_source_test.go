@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsZonesDataSource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.zones["example.com"] = &fakeZone{name: "example.com", zoneType: "Primary", serial: 7}
+					server.zones["example.net"] = &fakeZone{name: "example.net", zoneType: "Forwarder", disabled: true}
+				},
+				Config: testAccProviderConfig(server) + `data "technitium_dns_zones" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "dns_zones.#", "2"),
+					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "dns_zones.0.zone", "example.com"),
+					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "dns_zones.0.type", "Primary"),
+					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "dns_zones.0.status", "Enabled"),
+					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "dns_zones.0.serial", "7"),
+					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "dns_zones.1.zone", "example.net"),
+					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "dns_zones.1.status", "Disabled"),
+				),
+			},
+		},
+	})
+}
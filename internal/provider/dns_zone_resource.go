@@ -5,19 +5,25 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"terraform-provider-technitium/internal/provider/technitium"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &dnsZoneResource{}
-	_ resource.ResourceWithConfigure = &dnsZoneResource{}
+	_ resource.Resource                = &dnsZoneResource{}
+	_ resource.ResourceWithConfigure   = &dnsZoneResource{}
+	_ resource.ResourceWithImportState = &dnsZoneResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsZoneResource{}
 )
 
 func GetMD5Hash(text string) string {
@@ -33,7 +39,7 @@ func NewDnsZoneResource() resource.Resource {
 // dnsZoneResource is the resource implementation.
 
 type dnsZoneResource struct {
-	client *technitium.APIClient
+	client *technitiumClient
 }
 
 // orderResourceModel maps the resource schema data.
@@ -58,9 +64,15 @@ func (r *dnsZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			},
 			"name": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
@@ -84,20 +96,8 @@ func (r *dnsZoneResource) Create(ctx context.Context, req resource.CreateRequest
 	zone = zone.Zone(plan.Name.ValueString())
 	zone = zone.Type_(plan.Type.ValueString())
 	answ, _, err := zone.Execute()
-
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating dns zone",
-			"Could not create dns zone, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	if answ.GetStatus() != "ok" {
-		resp.Diagnostics.AddError(
-			"Error creating dns zone",
-			"Could not create dns zone, unexpected error: "+answ.GetErrorMessage(),
-		)
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "create", "dns zone", plan.Name.ValueString(), err)
 		return
 	}
 
@@ -116,6 +116,48 @@ func (r *dnsZoneResource) Create(ctx context.Context, req resource.CreateRequest
 
 // Read refreshes the Terraform state with the latest data.
 func (r *dnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsZoneResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed zone from Technitium
+	answ, _, err := r.client.DnsZoneAPI.ListDnsZones(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "dns zone", state.Name.ValueString(), err)
+		return
+	}
+
+	var zone *technitium.DnsZone
+	for i := range answ.Response.Zones {
+		if strings.EqualFold(answ.Response.Zones[i].GetName(), strings.TrimSuffix(state.Name.ValueString(), ".")) {
+			zone = &answ.Response.Zones[i]
+			break
+		}
+	}
+
+	// The zone was deleted outside of Terraform.
+	if zone == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Technitium accepts the zone type in any case; keep the configured
+	// spelling unless the type actually changed.
+	if !strings.EqualFold(state.Type.ValueString(), zone.GetType()) {
+		state.Type = types.StringValue(zone.GetType())
+	}
+	state.ID = types.StringValue(GetMD5Hash(state.Name.ValueString()))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -137,22 +179,35 @@ func (r *dnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest
 	zone := r.client.DnsZoneAPI.DeleteDnsZone(ctx)
 	zone = zone.Zone(state.Name.ValueString())
 	answ, _, err := zone.Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "delete", "dns zone", state.Name.ValueString(), err)
+		return
+	}
+}
 
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting dns zone",
-			"Could not delete dns zone, unexpected error: "+err.Error(),
-		)
+// ModifyPlan rejects zone types the server is too old for.
+func (r *dnsZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the zone is destroyed or the provider is not
+	// configured yet.
+	if req.Plan.Raw.IsNull() || r.client == nil {
 		return
 	}
 
-	if answ.GetStatus() != "ok" {
-		resp.Diagnostics.AddError(
-			"Error creating dns zone",
-			"Could not create dns zone, unexpected error: "+answ.GetErrorMessage(),
-		)
+	var zoneType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &zoneType)...)
+	if resp.Diagnostics.HasError() || zoneType.IsUnknown() {
 		return
 	}
+
+	switch strings.ToLower(zoneType.ValueString()) {
+	case "catalog", "secondarycatalog", "secondaryforwarder":
+		r.client.requireFeature(&resp.Diagnostics, path.Root("type"), featureCatalogZones)
+	}
+}
+
+// ImportState imports an existing zone by its name.
+func (r *dnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
 
 // Configure adds the provider configured client to the resource.
@@ -163,12 +218,12 @@ func (r *dnsZoneResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*technitium.APIClient)
+	client, ok := req.ProviderData.(*technitiumClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *technitiumclient.TechnitiumDNSClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
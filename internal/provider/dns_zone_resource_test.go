@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDnsZoneResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDnsZoneDestroy(server, "example.com"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccDnsZoneResourceConfig("example.com", "Primary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone.test", "name", "example.com"),
+					resource.TestCheckResourceAttr("technitium_dns_zone.test", "type", "Primary"),
+					resource.TestCheckResourceAttr("technitium_dns_zone.test", "id", GetMD5Hash("example.com")),
+					resource.TestCheckResourceAttrSet("technitium_dns_zone.test", "last_updated"),
+					testAccCheckDnsZoneExists(server, "example.com", "Primary"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "technitium_dns_zone.test",
+				ImportState:             true,
+				ImportStateId:           "example.com",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_updated"},
+			},
+			// Changing the type replaces the zone
+			{
+				Config: testAccProviderConfig(server) + testAccDnsZoneResourceConfig("example.com", "Forwarder"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone.test", "type", "Forwarder"),
+					testAccCheckDnsZoneExists(server, "example.com", "Forwarder"),
+				),
+			},
+			// A zone deleted outside of Terraform is created again
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					delete(server.zones, "example.com")
+				},
+				Config: testAccProviderConfig(server) + testAccDnsZoneResourceConfig("example.com", "Forwarder"),
+				Check:  testAccCheckDnsZoneExists(server, "example.com", "Forwarder"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsZoneResource_alreadyExists(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.zones["example.com"] = &fakeZone{name: "example.com", zoneType: "Primary"}
+				},
+				Config:      testAccProviderConfig(server) + testAccDnsZoneResourceConfig("example.com", "Primary"),
+				ExpectError: regexp.MustCompile(`Zone\s+already\s+exists:\s+example.com`),
+			},
+		},
+	})
+}
+
+func TestAccDnsZoneResource_unsupportedType(t *testing.T) {
+	server := newFakeServer(t)
+	server.version = "12.2.1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccDnsZoneResourceConfig("example.com", "Catalog"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`require\s+Technitium\s+DNS\s+Server\s+13.0\s+or\s+later,\s+but\s+the\s+server\s+runs\s+version\s+12.2.1`),
+			},
+		},
+	})
+}
+
+func testAccDnsZoneResourceConfig(name string, zoneType string) string {
+	return fmt.Sprintf(`
+resource "technitium_dns_zone" "test" {
+  name = %q
+  type = %q
+}
+`, name, zoneType)
+}
+
+func testAccCheckDnsZoneExists(server *fakeServer, name string, zoneType string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		zone := server.zone(name)
+		if zone == nil {
+			return fmt.Errorf("zone %s does not exist on the server", name)
+		}
+		if zone.zoneType != zoneType {
+			return fmt.Errorf("zone %s has type %s, expected %s", name, zone.zoneType, zoneType)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDnsZoneDestroy(server *fakeServer, name string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if server.zone(name) != nil {
+			return fmt.Errorf("zone %s still exists on the server", name)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// fakeApp is an installed DNS app of a fake server.
+type fakeApp struct {
+	name    string
+	version string
+	config  string
+}
+
+// fakeStoreAppURL is the download url of a version of a store app.
+func fakeStoreAppURL(name string, version string) string {
+	return "https://download.technitium.com/dns/apps/" + strings.ReplaceAll(name, " ", "") + "App-v" + version + ".zip"
+}
+
+func (s *fakeServer) listApps(_ url.Values, _ string) (interface{}, error) {
+	names := make([]string, 0, len(s.apps))
+	for name := range s.apps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	apps := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		apps = append(apps, s.apps[name].answer())
+	}
+	return map[string]interface{}{"apps": apps}, nil
+}
+
+func (s *fakeServer) listStoreApps(_ url.Values, _ string) (interface{}, error) {
+	if s.storeError != "" {
+		return nil, errors.New(s.storeError)
+	}
+	names := make([]string, 0, len(s.store))
+	for name := range s.store {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	storeApps := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		storeApp := map[string]interface{}{
+			"name":      name,
+			"version":   s.store[name],
+			"url":       fakeStoreAppURL(name, s.store[name]),
+			"installed": s.apps[name] != nil,
+		}
+		if app := s.apps[name]; app != nil {
+			storeApp["installedVersion"] = app.version
+			storeApp["updateAvailable"] = app.version != s.store[name]
+		}
+		storeApps = append(storeApps, storeApp)
+	}
+	return map[string]interface{}{"storeApps": storeApps}, nil
+}
+
+func (s *fakeServer) downloadAndInstallApp(params url.Values, _ string) (interface{}, error) {
+	return s.installApp(params, s.downloadedVersion, false)
+}
+
+func (s *fakeServer) downloadAndUpdateApp(params url.Values, _ string) (interface{}, error) {
+	return s.installApp(params, s.downloadedVersion, true)
+}
+
+func (s *fakeServer) uploadAndInstallApp(params url.Values, _ string) (interface{}, error) {
+	return s.installApp(params, fakeUploadedVersion, false)
+}
+
+func (s *fakeServer) uploadAndUpdateApp(params url.Values, _ string) (interface{}, error) {
+	return s.installApp(params, fakeUploadedVersion, true)
+}
+
+// installApp installs or updates an app, with the version read from the
+// request.
+func (s *fakeServer) installApp(params url.Values, version func(url.Values) (string, error), update bool) (interface{}, error) {
+	name, err := fakeParam(params, "name")
+	if err != nil {
+		return nil, err
+	}
+	app, installed := s.apps[name]
+	switch {
+	case update && !installed:
+		return nil, fmt.Errorf("DNS application does not exist: %s", name)
+	case !update && installed:
+		return nil, fmt.Errorf("DNS application already exists: %s", name)
+	}
+	v, err := version(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if !installed {
+		app = &fakeApp{name: name, config: "{}"}
+		s.apps[name] = app
+	}
+	app.version = v
+	if update {
+		return map[string]interface{}{"updatedApp": app.answer()}, nil
+	}
+	return map[string]interface{}{"installedApp": app.answer()}, nil
+}
+
+// downloadedVersion is the version of the store app at the url, 1.0 for
+// other urls.
+func (s *fakeServer) downloadedVersion(params url.Values) (string, error) {
+	location, err := fakeParam(params, "url")
+	if err != nil {
+		return "", err
+	}
+	for name, version := range s.store {
+		if location == fakeStoreAppURL(name, version) {
+			return version, nil
+		}
+	}
+	return "1.0", nil
+}
+
+// fakeUploadedVersion is the content of the version file of the uploaded
+// zip file.
+func fakeUploadedVersion(params url.Values) (string, error) {
+	file := params.Get("file")
+	archive, err := zip.NewReader(bytes.NewReader([]byte(file)), int64(len(file)))
+	if err != nil {
+		return "", fmt.Errorf("Invalid DNS application zip file: %s", err)
+	}
+	for _, entry := range archive.File {
+		if entry.Name != "version" {
+			continue
+		}
+		content, err := entry.Open()
+		if err != nil {
+			return "", err
+		}
+		defer content.Close()
+		version, err := io.ReadAll(content)
+		return strings.TrimSpace(string(version)), err
+	}
+	return "", fmt.Errorf("Invalid DNS application zip file: no version")
+}
+
+func (s *fakeServer) uninstallApp(params url.Values, _ string) (interface{}, error) {
+	name, err := fakeParam(params, "name")
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.apps[name]; !ok {
+		return nil, fmt.Errorf("DNS application does not exist: %s", name)
+	}
+	delete(s.apps, name)
+	return map[string]interface{}{}, nil
+}
+
+func (a *fakeApp) answer() map[string]interface{} {
+	return map[string]interface{}{
+		"name":    a.name,
+		"version": a.version,
+		"dnsApps": []interface{}{},
+	}
+}
+
+// appVersion returns the version of an installed app, or "" when the app is
+// not installed.
+func (s *fakeServer) appVersion(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if app, ok := s.apps[name]; ok {
+		return app.version
+	}
+	return ""
+}
+
+// readFakeUpload returns the content of an uploaded file.
+func readFakeUpload(header *multipart.FileHeader) (string, error) {
+	file, err := header.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	content, err := io.ReadAll(file)
+	return string(content), err
+}
+
+func (s *fakeServer) getAppConfig(params url.Values, _ string) (interface{}, error) {
+	name, err := fakeParam(params, "name")
+	if err != nil {
+		return nil, err
+	}
+	app, ok := s.apps[name]
+	if !ok {
+		return nil, fmt.Errorf("DNS application does not exist: %s", name)
+	}
+	return map[string]interface{}{"config": app.config}, nil
+}
+
+func (s *fakeServer) setAppConfig(params url.Values, _ string) (interface{}, error) {
+	name, err := fakeParam(params, "name")
+	if err != nil {
+		return nil, err
+	}
+	app, ok := s.apps[name]
+	if !ok {
+		return nil, fmt.Errorf("DNS application does not exist: %s", name)
+	}
+	app.config = params.Get("config")
+	return map[string]interface{}{}, nil
+}
+
+// appConfig returns the config of an installed app.
+func (s *fakeServer) appConfig(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if app, ok := s.apps[name]; ok {
+		return app.config
+	}
+	return ""
+}
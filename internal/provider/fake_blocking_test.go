@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// fakeManualZone lists, adds and deletes the domains of the allowed or the
+// blocked zone of a fake server.
+type fakeManualZone map[string]bool
+
+func (z fakeManualZone) list(params url.Values) (interface{}, error) {
+	domain := strings.ToLower(params.Get("domain"))
+	for {
+		subZones := z.subZones(domain)
+		if z[domain] || len(subZones) != 1 {
+			return z.answer(domain, subZones), nil
+		}
+		// Like the server, descend into a domain with a single subdomain.
+		domain = strings.TrimSuffix(subZones[0]+"."+domain, ".")
+	}
+}
+
+func (z fakeManualZone) subZones(domain string) []string {
+	labels := map[string]bool{}
+	for entry := range z {
+		var rest string
+		switch {
+		case domain == "":
+			rest = entry
+		case strings.HasSuffix(entry, "."+domain):
+			rest = strings.TrimSuffix(entry, "."+domain)
+		default:
+			continue
+		}
+		labels[rest[strings.LastIndex(rest, ".")+1:]] = true
+	}
+	subZones := make([]string, 0, len(labels))
+	for label := range labels {
+		subZones = append(subZones, label)
+	}
+	sort.Strings(subZones)
+	return subZones
+}
+
+func (z fakeManualZone) answer(domain string, subZones []string) interface{} {
+	records := []interface{}{}
+	if z[domain] {
+		records = append(records, map[string]interface{}{
+			"name":  domain,
+			"type":  "SOA",
+			"ttl":   60,
+			"rData": map[string]interface{}{"primaryNameServer": "dns.fake"},
+		})
+	}
+	return map[string]interface{}{"domain": domain, "zones": subZones, "records": records}
+}
+
+// export lists the domains of the zone, one per line.
+func (z fakeManualZone) export() (interface{}, error) {
+	domains := make([]string, 0, len(z))
+	for domain := range z {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	var file strings.Builder
+	for _, domain := range domains {
+		file.WriteString(domain + "\r\n")
+	}
+	return fakeFile(file.String()), nil
+}
+
+func (z fakeManualZone) add(params url.Values) (interface{}, error) {
+	domain, err := fakeParam(params, "domain")
+	if err != nil {
+		return nil, err
+	}
+	z[strings.ToLower(domain)] = true
+	return map[string]interface{}{}, nil
+}
+
+func (z fakeManualZone) delete(params url.Values) (interface{}, error) {
+	domain, err := fakeParam(params, "domain")
+	if err != nil {
+		return nil, err
+	}
+	delete(z, strings.ToLower(domain))
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) listAllowedZones(params url.Values, _ string) (interface{}, error) {
+	return s.allowed.list(params)
+}
+
+func (s *fakeServer) exportAllowedZones(_ url.Values, _ string) (interface{}, error) {
+	return s.allowed.export()
+}
+
+func (s *fakeServer) addAllowedZone(params url.Values, _ string) (interface{}, error) {
+	return s.allowed.add(params)
+}
+
+func (s *fakeServer) deleteAllowedZone(params url.Values, _ string) (interface{}, error) {
+	return s.allowed.delete(params)
+}
+
+func (s *fakeServer) listBlockedZones(params url.Values, _ string) (interface{}, error) {
+	return s.blocked.list(params)
+}
+
+func (s *fakeServer) exportBlockedZones(_ url.Values, _ string) (interface{}, error) {
+	return s.blocked.export()
+}
+
+func (s *fakeServer) addBlockedZone(params url.Values, _ string) (interface{}, error) {
+	return s.blocked.add(params)
+}
+
+func (s *fakeServer) deleteBlockedZone(params url.Values, _ string) (interface{}, error) {
+	return s.blocked.delete(params)
+}
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// fakeCluster is the cluster a fake server belongs to, as seen by it.
+type fakeCluster struct {
+	domain string
+	nodes  []*fakeClusterNode
+	nextID int
+
+	heartbeatRefreshInterval int
+	heartbeatRetryInterval   int
+	configRefreshInterval    int
+	configRetryInterval      int
+
+	// primaryURL is set on secondary nodes.
+	primaryURL string
+}
+
+type fakeClusterNode struct {
+	id          int
+	name        string
+	url         string
+	ipAddresses []string
+	nodeType    string
+	state       string
+}
+
+func (s *fakeServer) dnsServerDomain() string {
+	domain, _ := s.settings["dnsServerDomain"].(string)
+	return domain
+}
+
+func (s *fakeServer) clusterState(_ url.Values, _ string) (interface{}, error) {
+	return s.clusterInfo(), nil
+}
+
+func (s *fakeServer) clusterInfo() map[string]interface{} {
+	info := map[string]interface{}{
+		"clusterInitialized": s.cluster != nil,
+		"dnsServerDomain":    s.dnsServerDomain(),
+		"version":            s.version,
+	}
+	if s.cluster == nil {
+		return info
+	}
+
+	nodes := make([]interface{}, 0, len(s.cluster.nodes))
+	for _, node := range s.cluster.nodes {
+		nodes = append(nodes, map[string]interface{}{
+			"id":          node.id,
+			"name":        node.name,
+			"url":         node.url,
+			"ipAddresses": node.ipAddresses,
+			"type":        node.nodeType,
+			"state":       node.state,
+			"upSince":     "2024-01-01T00:00:00Z",
+			"lastSeen":    "2024-01-01T00:00:00Z",
+		})
+	}
+	info["clusterDomain"] = s.cluster.domain
+	info["heartbeatRefreshIntervalSeconds"] = s.cluster.heartbeatRefreshInterval
+	info["heartbeatRetryIntervalSeconds"] = s.cluster.heartbeatRetryInterval
+	info["configRefreshIntervalSeconds"] = s.cluster.configRefreshInterval
+	info["configRetryIntervalSeconds"] = s.cluster.configRetryInterval
+	info["configLastSynced"] = "2024-01-01T00:00:00Z"
+	info["clusterNodes"] = nodes
+	return info
+}
+
+func (s *fakeServer) initCluster(params url.Values, _ string) (interface{}, error) {
+	domain, err := fakeParam(params, "clusterDomain")
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := fakeParam(params, "primaryNodeIpAddresses")
+	if err != nil {
+		return nil, err
+	}
+	if s.cluster != nil {
+		return nil, errors.New("The cluster is already initialized.")
+	}
+
+	s.cluster = &fakeCluster{
+		domain:                   fakeDomain(domain),
+		heartbeatRefreshInterval: 30,
+		heartbeatRetryInterval:   10,
+		configRefreshInterval:    900,
+		configRetryInterval:      60,
+		nextID:                   2,
+		nodes: []*fakeClusterNode{{
+			id:          1,
+			name:        s.dnsServerDomain(),
+			url:         s.URL + "/",
+			ipAddresses: strings.Split(addresses, ","),
+			nodeType:    "Primary",
+			state:       "Self",
+		}},
+	}
+	return s.clusterInfo(), nil
+}
+
+func (s *fakeServer) deleteCluster(params url.Values, _ string) (interface{}, error) {
+	if s.cluster == nil || s.cluster.primaryURL != "" {
+		return nil, errors.New("This server is not the primary node of a cluster.")
+	}
+	if len(s.cluster.nodes) > 1 && params.Get("forceDelete") != "true" {
+		return nil, errors.New("Cannot delete the cluster while secondary nodes are joined. Remove the secondary nodes or force delete the cluster.")
+	}
+	s.cluster = nil
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) setClusterOptions(params url.Values, _ string) (interface{}, error) {
+	if s.cluster == nil || s.cluster.primaryURL != "" {
+		return nil, errors.New("This server is not the primary node of a cluster.")
+	}
+	options := map[string]*int{
+		"heartbeatRefreshIntervalSeconds": &s.cluster.heartbeatRefreshInterval,
+		"heartbeatRetryIntervalSeconds":   &s.cluster.heartbeatRetryInterval,
+		"configRefreshIntervalSeconds":    &s.cluster.configRefreshInterval,
+		"configRetryIntervalSeconds":      &s.cluster.configRetryInterval,
+	}
+	for key, option := range options {
+		if !params.Has(key) {
+			continue
+		}
+		value, err := strconv.Atoi(params.Get(key))
+		if err != nil || value < 1 {
+			return nil, fmt.Errorf("Invalid value for %s: %s", key, params.Get(key))
+		}
+		*option = value
+	}
+	return s.clusterInfo(), nil
+}
+
+// joinSecondary registers a secondary node joining the cluster.
+func (s *fakeServer) joinSecondary(params url.Values) error {
+	if s.cluster == nil || s.cluster.primaryURL != "" {
+		return errors.New("This server is not the primary node of a cluster.")
+	}
+	nodeURL, err := fakeParam(params, "secondaryNodeUrl")
+	if err != nil {
+		return err
+	}
+	addresses, err := fakeParam(params, "secondaryNodeIpAddresses")
+	if err != nil {
+		return err
+	}
+
+	s.cluster.nodes = append(s.cluster.nodes, &fakeClusterNode{
+		id:          s.cluster.nextID,
+		name:        params.Get("secondaryNodeName"),
+		url:         nodeURL,
+		ipAddresses: strings.Split(addresses, ","),
+		nodeType:    "Secondary",
+		state:       "Connected",
+	})
+	s.cluster.nextID++
+	return nil
+}
+
+// removeSecondary removes a secondary node leaving the cluster.
+func (s *fakeServer) removeSecondary(id int) error {
+	if s.cluster == nil || s.cluster.primaryURL != "" {
+		return errors.New("This server is not the primary node of a cluster.")
+	}
+	for i, node := range s.cluster.nodes {
+		if node.id == id && node.nodeType == "Secondary" {
+			s.cluster.nodes = append(s.cluster.nodes[:i], s.cluster.nodes[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("No secondary node was found with ID: %d", id)
+}
+
+// removeSecondaryNode removes a secondary node that cannot leave the cluster
+// by itself.
+func (s *fakeServer) removeSecondaryNode(params url.Values, _ string) (interface{}, error) {
+	value, err := fakeParam(params, "secondaryNodeId")
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid secondary node ID: %s", value)
+	}
+	if err := s.removeSecondary(id); err != nil {
+		return nil, err
+	}
+	return s.clusterInfo(), nil
+}
+
+// joinCluster joins this server to the cluster of the primary node, logging
+// in to the primary node and registering with it like the real server does.
+// The nodes talk to each other in-process.
+func (s *fakeServer) joinCluster(params url.Values, _ string) (interface{}, error) {
+	for _, key := range []string{"secondaryNodeIpAddresses", "primaryNodeUrl", "primaryNodeUsername", "primaryNodePassword"} {
+		if _, err := fakeParam(params, key); err != nil {
+			return nil, err
+		}
+	}
+	if s.cluster != nil {
+		return nil, errors.New("This server is already part of a cluster.")
+	}
+	primaryURL := strings.TrimSuffix(params.Get("primaryNodeUrl"), "/")
+	primary, err := s.fakeNode(primaryURL)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to join the cluster: %s", err)
+	}
+
+	primary.mu.Lock()
+	defer primary.mu.Unlock()
+
+	_, err = primary.login(url.Values{
+		"user": {params.Get("primaryNodeUsername")},
+		"pass": {params.Get("primaryNodePassword")},
+	}, "")
+	if err == nil {
+		err = primary.joinSecondary(url.Values{
+			"secondaryNodeUrl":         {s.URL + "/"},
+			"secondaryNodeName":        {s.dnsServerDomain()},
+			"secondaryNodeIpAddresses": {params.Get("secondaryNodeIpAddresses")},
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Failed to join the cluster: %s", err)
+	}
+
+	s.cluster = &fakeCluster{
+		domain:     primary.cluster.domain,
+		primaryURL: primaryURL,
+	}
+	for _, node := range primary.cluster.nodes {
+		state := "Connected"
+		if node.url == s.URL+"/" {
+			state = "Self"
+		}
+		s.cluster.nodes = append(s.cluster.nodes, &fakeClusterNode{
+			id:          node.id,
+			name:        node.name,
+			url:         node.url,
+			ipAddresses: node.ipAddresses,
+			nodeType:    node.nodeType,
+			state:       state,
+		})
+	}
+	return s.clusterInfo(), nil
+}
+
+func (s *fakeServer) leaveCluster(params url.Values, _ string) (interface{}, error) {
+	if s.cluster == nil || s.cluster.primaryURL == "" {
+		return nil, errors.New("This server is not a secondary node of a cluster.")
+	}
+
+	var self *fakeClusterNode
+	for _, node := range s.cluster.nodes {
+		if node.state == "Self" {
+			self = node
+		}
+	}
+
+	// A forced leave does not tell the primary node.
+	if params.Get("forceLeave") != "true" {
+		primary, err := s.fakeNode(s.cluster.primaryURL)
+		if err == nil {
+			primary.mu.Lock()
+			err = primary.removeSecondary(self.id)
+			primary.mu.Unlock()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to leave the cluster: %s", err)
+		}
+	}
+
+	s.cluster = nil
+	return map[string]interface{}{}, nil
+}
+
+// fakeNode returns the running fake server at a URL, as another node of a
+// cluster.
+func (s *fakeServer) fakeNode(nodeURL string) (*fakeServer, error) {
+	node, ok := fakeServers.Load(strings.TrimSuffix(nodeURL, "/"))
+	if !ok || node == s {
+		return nil, fmt.Errorf("No other node answers on %s", nodeURL)
+	}
+	return node.(*fakeServer), nil
+}
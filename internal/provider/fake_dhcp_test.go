@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// fakeDhcpScope is a DHCP scope of a fake server. options holds the scope
+// configuration in the shape the get call answers with.
+type fakeDhcpScope struct {
+	name    string
+	enabled bool
+	options map[string]interface{}
+	leases  []map[string]interface{}
+}
+
+// Scope options by the way they are encoded in the set call.
+var (
+	fakeDhcpStringOptions = []string{"startingAddress", "endingAddress", "subnetMask", "routerAddress", "domainName"}
+	fakeDhcpIntOptions    = []string{"leaseTimeDays", "leaseTimeHours", "leaseTimeMinutes", "dnsTtl"}
+	fakeDhcpBoolOptions   = []string{"useThisDnsServer", "dnsUpdates"}
+	fakeDhcpListOptions   = []string{"dnsServers", "domainSearchList", "ntpServers", "ntpServerDomainNames"}
+	fakeDhcpTableOptions  = map[string][]string{
+		"exclusions":     {"startingAddress", "endingAddress"},
+		"staticRoutes":   {"destination", "subnetMask", "router"},
+		"vendorInfo":     {"identifier", "information"},
+		"reservedLeases": {"hostName", "hardwareAddress", "address", "comments"},
+	}
+)
+
+func (s *fakeServer) dhcpScope(params url.Values) (*fakeDhcpScope, error) {
+	name, err := fakeParam(params, "name")
+	if err != nil {
+		return nil, err
+	}
+	scope, ok := s.scopes[name]
+	if !ok {
+		return nil, fmt.Errorf("DHCP scope does not exist: %s", name)
+	}
+	return scope, nil
+}
+
+func (s *fakeServer) listDhcpScopes(_ url.Values, _ string) (interface{}, error) {
+	names := make([]string, 0, len(s.scopes))
+	for name := range s.scopes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	scopes := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		scope := s.scopes[name]
+		scopes = append(scopes, map[string]interface{}{
+			"name":            scope.name,
+			"enabled":         scope.enabled,
+			"startingAddress": scope.options["startingAddress"],
+			"endingAddress":   scope.options["endingAddress"],
+			"subnetMask":      scope.options["subnetMask"],
+		})
+	}
+	return map[string]interface{}{"scopes": scopes}, nil
+}
+
+func (s *fakeServer) listDhcpLeases(_ url.Values, _ string) (interface{}, error) {
+	names := make([]string, 0, len(s.scopes))
+	for name := range s.scopes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	leases := []interface{}{}
+	for _, name := range names {
+		for _, lease := range s.scopes[name].leases {
+			answer := map[string]interface{}{"scope": name}
+			for key, value := range lease {
+				answer[key] = value
+			}
+			leases = append(leases, answer)
+		}
+	}
+	return map[string]interface{}{"leases": leases}, nil
+}
+
+func (s *fakeServer) getDhcpScope(params url.Values, _ string) (interface{}, error) {
+	scope, err := s.dhcpScope(params)
+	if err != nil {
+		return nil, err
+	}
+
+	answer := map[string]interface{}{"name": scope.name}
+	for key, value := range scope.options {
+		answer[key] = value
+	}
+	return answer, nil
+}
+
+func (s *fakeServer) setDhcpScope(params url.Values, _ string) (interface{}, error) {
+	name, err := fakeParam(params, "name")
+	if err != nil {
+		return nil, err
+	}
+
+	scope, ok := s.scopes[name]
+	if !ok {
+		for _, key := range []string{"startingAddress", "endingAddress", "subnetMask"} {
+			if _, err := fakeParam(params, key); err != nil {
+				return nil, err
+			}
+		}
+		scope = &fakeDhcpScope{
+			name: name,
+			options: map[string]interface{}{
+				"leaseTimeDays":    7,
+				"leaseTimeHours":   0,
+				"leaseTimeMinutes": 0,
+				"useThisDnsServer": false,
+				"dnsUpdates":       true,
+				"dnsTtl":           900,
+			},
+		}
+	}
+
+	// Parse every option before changing the scope, so that a bad value
+	// leaves it untouched.
+	options := map[string]interface{}{}
+	for _, key := range fakeDhcpStringOptions {
+		if params.Has(key) {
+			options[key] = params.Get(key)
+		}
+	}
+	for _, key := range fakeDhcpIntOptions {
+		if params.Has(key) {
+			value, err := strconv.Atoi(params.Get(key))
+			if err != nil || value < 0 {
+				return nil, fmt.Errorf("Invalid value for %s: %s", key, params.Get(key))
+			}
+			options[key] = value
+		}
+	}
+	for _, key := range fakeDhcpBoolOptions {
+		if params.Has(key) {
+			options[key] = params.Get(key) == "true"
+		}
+	}
+	for _, key := range fakeDhcpListOptions {
+		if params.Has(key) {
+			options[key] = fakeList(params.Get(key))
+		}
+	}
+	for key, columns := range fakeDhcpTableOptions {
+		if params.Has(key) {
+			rows, err := fakeTable(params.Get(key), columns)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid value for %s: %s", key, err)
+			}
+			options[key] = rows
+		}
+	}
+
+	if newName := params.Get("newName"); newName != "" && newName != name {
+		if _, ok := s.scopes[newName]; ok {
+			return nil, fmt.Errorf("DHCP scope already exists: %s", newName)
+		}
+		delete(s.scopes, name)
+		scope.name = newName
+	}
+	for key, value := range options {
+		scope.options[key] = value
+	}
+	s.scopes[scope.name] = scope
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) enableDhcpScope(params url.Values, _ string) (interface{}, error) {
+	scope, err := s.dhcpScope(params)
+	if err != nil {
+		return nil, err
+	}
+	if s.dhcpEnableError != "" {
+		return nil, errors.New(s.dhcpEnableError)
+	}
+	scope.enabled = true
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) disableDhcpScope(params url.Values, _ string) (interface{}, error) {
+	scope, err := s.dhcpScope(params)
+	if err != nil {
+		return nil, err
+	}
+	scope.enabled = false
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) deleteDhcpScope(params url.Values, _ string) (interface{}, error) {
+	scope, err := s.dhcpScope(params)
+	if err != nil {
+		return nil, err
+	}
+	delete(s.scopes, scope.name)
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) addReservedLease(params url.Values, _ string) (interface{}, error) {
+	scope, err := s.dhcpScope(params)
+	if err != nil {
+		return nil, err
+	}
+	hardwareAddress, err := fakeParam(params, "hardwareAddress")
+	if err != nil {
+		return nil, err
+	}
+	address, err := fakeParam(params, "ipAddress")
+	if err != nil {
+		return nil, err
+	}
+
+	hardwareAddress = fakeHardwareAddress(hardwareAddress)
+	leases := fakeReservedLeases(scope)
+	for _, lease := range leases {
+		if lease["hardwareAddress"] == hardwareAddress {
+			return nil, fmt.Errorf("Reserved lease already exists: %s", hardwareAddress)
+		}
+		if lease["address"] == address {
+			return nil, fmt.Errorf("The address %s is already reserved for %s", address, lease["hardwareAddress"])
+		}
+	}
+	scope.options["reservedLeases"] = append(leases, map[string]string{
+		"hostName":        params.Get("hostName"),
+		"hardwareAddress": hardwareAddress,
+		"address":         address,
+		"comments":        params.Get("comments"),
+	})
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) removeReservedLease(params url.Values, _ string) (interface{}, error) {
+	scope, err := s.dhcpScope(params)
+	if err != nil {
+		return nil, err
+	}
+	hardwareAddress, err := fakeParam(params, "hardwareAddress")
+	if err != nil {
+		return nil, err
+	}
+
+	hardwareAddress = fakeHardwareAddress(hardwareAddress)
+	leases := fakeReservedLeases(scope)
+	for i, lease := range leases {
+		if lease["hardwareAddress"] == hardwareAddress {
+			scope.options["reservedLeases"] = append(leases[:i], leases[i+1:]...)
+			return map[string]interface{}{}, nil
+		}
+	}
+	return nil, fmt.Errorf("No reserved lease was found for: %s", hardwareAddress)
+}
+
+func fakeReservedLeases(scope *fakeDhcpScope) []map[string]string {
+	leases, _ := scope.options["reservedLeases"].([]map[string]string)
+	return leases
+}
+
+// fakeHardwareAddress formats a MAC address the way the server reports it.
+func fakeHardwareAddress(address string) string {
+	return strings.ToUpper(strings.ReplaceAll(address, ":", "-"))
+}
+
+// dhcpScopeOptions returns a copy of the options of the named scope, or nil.
+func (s *fakeServer) dhcpScopeOptions(name string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scope, ok := s.scopes[name]
+	if !ok {
+		return nil
+	}
+	options := map[string]interface{}{"enabled": scope.enabled}
+	for key, value := range scope.options {
+		options[key] = value
+	}
+	return options
+}
+
+// fakeList decodes a comma separated list parameter, where "false" clears
+// the list.
+func fakeList(value string) []string {
+	if value == "false" || value == "" {
+		return []string{}
+	}
+	return strings.Split(value, ",")
+}
+
+// fakeTable decodes a pipe separated table parameter into rows keyed by the
+// given columns, where "false" clears the table.
+func fakeTable(value string, columns []string) ([]map[string]string, error) {
+	rows := []map[string]string{}
+	if value == "false" || value == "" {
+		return rows, nil
+	}
+
+	cells := strings.Split(value, "|")
+	if len(cells)%len(columns) != 0 {
+		return nil, fmt.Errorf("expected rows of %d values, got %d values", len(columns), len(cells))
+	}
+	for i := 0; i < len(cells); i += len(columns) {
+		row := map[string]string{}
+		for j, column := range columns {
+			row[column] = cells[i+j]
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
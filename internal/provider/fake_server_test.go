@@ -0,0 +1,800 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	fakeUsername = "admin"
+	fakePassword = "admin"
+	fakeAPIToken = "fake-api-token"
+	fakeVersion  = "13.0"
+	fakeTTL      = 3600
+)
+
+// fakeServer is an in-process stand-in for the Technitium DNS Server API. It
+// keeps users, sessions, zones and records in memory and answers with the
+// same envelope, parameter handling and error messages as the real server,
+// so the provider can be tested without network access.
+type fakeServer struct {
+	*httptest.Server
+
+	mu               sync.Mutex
+	version          string
+	users            map[string]string // username -> password
+	tokens           map[string]string // token -> username, API tokens and sessions
+	sessions         map[string]bool   // tokens created by login
+	nextToken        int
+	zones            map[string]*fakeZone
+	settings         map[string]interface{}
+	cluster          *fakeCluster
+	scopes           map[string]*fakeDhcpScope
+	dhcpEnableError  string   // when set, enabling a DHCP scope fails with it
+	flushes          []string // domains flushed from the cache, "." for all
+	blockListUpdates int
+	allowed          fakeManualZone
+	apps             map[string]*fakeApp
+	store            map[string]string // store app name -> version
+	storeError       string            // when set, listing the app store fails with it
+	dropped          map[string]bool   // paths whose connections are closed unanswered
+	blocked          fakeManualZone
+	handlers         map[string]fakeHandler
+}
+
+// fakeServers are the running fake servers by URL, so that fake servers
+// forming a cluster can reach each other.
+var fakeServers sync.Map
+
+// fakeHandler answers an API call. The returned value is sent as the
+// "response" object, or merged into the top level when it is a fakeTopLevel.
+type fakeHandler func(s *fakeServer, params url.Values, username string) (interface{}, error)
+
+// fakeTopLevel is an answer whose fields are not wrapped in "response", as
+// used by the login calls.
+type fakeTopLevel map[string]interface{}
+
+// fakeFile is an answer sent as a plain text file instead of JSON, as used
+// by the export calls.
+type fakeFile string
+
+type fakeZone struct {
+	name         string
+	zoneType     string
+	disabled     bool
+	serial       int64
+	lastModified string
+	records      []*fakeRecord
+}
+
+type fakeRecord struct {
+	name     string
+	rrType   string
+	ttl      int64
+	rData    map[string]interface{}
+	disabled bool
+	comments string
+}
+
+// newFakeServer starts a fake server with the admin/admin user and the
+// fakeAPIToken API token. It is closed when the test ends.
+func newFakeServer(t *testing.T) *fakeServer {
+	t.Helper()
+
+	s := &fakeServer{
+		version:  fakeVersion,
+		users:    map[string]string{fakeUsername: fakePassword},
+		tokens:   map[string]string{fakeAPIToken: fakeUsername},
+		sessions: map[string]bool{},
+		zones:    map[string]*fakeZone{},
+		scopes:   map[string]*fakeDhcpScope{},
+		allowed:  fakeManualZone{},
+		blocked:  fakeManualZone{},
+		apps:     map[string]*fakeApp{},
+		store: map[string]string{
+			"Split Horizon":       "8.0",
+			"Query Logs (Sqlite)": "7.0",
+		},
+		settings: map[string]interface{}{
+			"uptimestamp":                     "2024-01-01T00:00:00Z",
+			"dnsServerDomain":                 "dns.fake",
+			"dnsServerLocalEndPoints":         []string{"0.0.0.0:53", "[::]:53"},
+			"defaultRecordTtl":                fakeTTL,
+			"dnssecValidation":                true,
+			"recursion":                       "AllowOnlyForPrivateNetworks",
+			"recursionNetworkACL":             []string{},
+			"randomizeName":                   true,
+			"qnameMinimization":               true,
+			"nsRevalidation":                  true,
+			"resolverRetries":                 2,
+			"resolverTimeout":                 1500,
+			"resolverConcurrency":             2,
+			"resolverMaxStackCount":           16,
+			"saveCache":                       true,
+			"serveStale":                      true,
+			"serveStaleTtl":                   259200,
+			"cacheMaximumEntries":             10000,
+			"cacheMinimumRecordTtl":           10,
+			"cacheMaximumRecordTtl":           604800,
+			"cacheNegativeRecordTtl":          300,
+			"cacheFailureRecordTtl":           10,
+			"cachePrefetchEligibility":        2,
+			"cachePrefetchTrigger":            9,
+			"enableBlocking":                  true,
+			"blockingType":                    "NxDomain",
+			"blockingAnswerTtl":               30,
+			"customBlockingAddresses":         []string{},
+			"blockListUrls":                   []string{},
+			"blockListUrlUpdateIntervalHours": 24,
+			"forwarders":                      []string{},
+			"forwarderProtocol":               "Udp",
+			"concurrentForwarding":            true,
+			"forwarderRetries":                3,
+			"forwarderTimeout":                2000,
+			"forwarderConcurrency":            2,
+		},
+		handlers: map[string]fakeHandler{
+			"/api/user/login":                        (*fakeServer).login,
+			"/api/user/logout":                       (*fakeServer).logout,
+			"/api/user/session/get":                  (*fakeServer).session,
+			"/api/zones/list":                        (*fakeServer).listZones,
+			"/api/zones/create":                      (*fakeServer).createZone,
+			"/api/zones/delete":                      (*fakeServer).deleteZone,
+			"/api/zones/enable":                      (*fakeServer).enableZone,
+			"/api/zones/disable":                     (*fakeServer).disableZone,
+			"/api/zones/records/add":                 (*fakeServer).addRecord,
+			"/api/zones/records/get":                 (*fakeServer).getRecords,
+			"/api/zones/records/update":              (*fakeServer).updateRecord,
+			"/api/zones/records/delete":              (*fakeServer).deleteRecord,
+			"/api/settings/get":                      (*fakeServer).getSettings,
+			"/api/settings/set":                      (*fakeServer).setSettings,
+			"/api/settings/forceUpdateBlockLists":    (*fakeServer).forceUpdateBlockLists,
+			"/api/settings/temporaryDisableBlocking": (*fakeServer).temporaryDisableBlocking,
+			"/api/allowed/list":                      (*fakeServer).listAllowedZones,
+			"/api/allowed/export":                    (*fakeServer).exportAllowedZones,
+			"/api/allowed/add":                       (*fakeServer).addAllowedZone,
+			"/api/allowed/delete":                    (*fakeServer).deleteAllowedZone,
+			"/api/blocked/list":                      (*fakeServer).listBlockedZones,
+			"/api/blocked/export":                    (*fakeServer).exportBlockedZones,
+			"/api/blocked/add":                       (*fakeServer).addBlockedZone,
+			"/api/blocked/delete":                    (*fakeServer).deleteBlockedZone,
+			"/api/cache/flush":                       (*fakeServer).flushCache,
+			"/api/cache/delete":                      (*fakeServer).deleteCachedZone,
+
+			"/api/admin/cluster/state":                   (*fakeServer).clusterState,
+			"/api/admin/cluster/init":                    (*fakeServer).initCluster,
+			"/api/admin/cluster/initJoin":                (*fakeServer).joinCluster,
+			"/api/admin/cluster/primary/delete":          (*fakeServer).deleteCluster,
+			"/api/admin/cluster/primary/setOptions":      (*fakeServer).setClusterOptions,
+			"/api/admin/cluster/primary/removeSecondary": (*fakeServer).removeSecondaryNode,
+			"/api/admin/cluster/secondary/leave":         (*fakeServer).leaveCluster,
+
+			"/api/dhcp/leases/list":    (*fakeServer).listDhcpLeases,
+			"/api/dhcp/scopes/list":    (*fakeServer).listDhcpScopes,
+			"/api/dhcp/scopes/get":     (*fakeServer).getDhcpScope,
+			"/api/dhcp/scopes/set":     (*fakeServer).setDhcpScope,
+			"/api/dhcp/scopes/enable":  (*fakeServer).enableDhcpScope,
+			"/api/dhcp/scopes/disable": (*fakeServer).disableDhcpScope,
+			"/api/dhcp/scopes/delete":  (*fakeServer).deleteDhcpScope,
+
+			"/api/dhcp/scopes/addReservedLease":    (*fakeServer).addReservedLease,
+			"/api/dhcp/scopes/removeReservedLease": (*fakeServer).removeReservedLease,
+
+			"/api/apps/list":               (*fakeServer).listApps,
+			"/api/apps/listStoreApps":      (*fakeServer).listStoreApps,
+			"/api/apps/downloadAndInstall": (*fakeServer).downloadAndInstallApp,
+			"/api/apps/downloadAndUpdate":  (*fakeServer).downloadAndUpdateApp,
+			"/api/apps/install":            (*fakeServer).uploadAndInstallApp,
+			"/api/apps/update":             (*fakeServer).uploadAndUpdateApp,
+			"/api/apps/uninstall":          (*fakeServer).uninstallApp,
+			"/api/apps/config/get":         (*fakeServer).getAppConfig,
+			"/api/apps/config/set":         (*fakeServer).setAppConfig,
+		},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	fakeServers.Store(s.URL, s)
+	t.Cleanup(func() {
+		fakeServers.Delete(s.URL)
+		s.Close()
+	})
+
+	return s
+}
+
+func (s *fakeServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	handler, ok := s.handlers[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// Uploaded files are passed to the handlers as form values.
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for key, files := range r.MultipartForm.File {
+			content, err := readFakeUpload(files[0])
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			r.Form.Set(key, content)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// A dropped call fails like a server that went away.
+	if s.dropped[r.URL.Path] {
+		if conn, _, err := http.NewResponseController(w).Hijack(); err == nil {
+			conn.Close()
+		}
+		return
+	}
+
+	var username string
+	if r.URL.Path != "/api/user/login" {
+		token := r.Form.Get("token")
+		if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			token = bearer
+		}
+		r.Form.Set("token", token)
+		if username, ok = s.tokens[token]; !ok {
+			writeFakeAnswer(w, fakeTopLevel{
+				"status":       statusInvalidToken,
+				"errorMessage": "Invalid token or session expired.",
+			})
+			return
+		}
+	}
+
+	answer, err := handler(s, r.Form, username)
+	if err != nil {
+		writeFakeAnswer(w, fakeTopLevel{
+			"status":       "error",
+			"errorMessage": err.Error(),
+		})
+		return
+	}
+
+	if file, ok := answer.(fakeFile); ok {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = io.WriteString(w, string(file))
+		return
+	}
+
+	envelope, ok := answer.(fakeTopLevel)
+	if !ok {
+		envelope = fakeTopLevel{"response": answer}
+	}
+	envelope["status"] = statusOk
+	writeFakeAnswer(w, envelope)
+}
+
+func writeFakeAnswer(w http.ResponseWriter, answer fakeTopLevel) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(answer)
+}
+
+// expireSessions drops every session token, as a server restart would.
+func (s *fakeServer) expireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token := range s.sessions {
+		delete(s.tokens, token)
+	}
+	s.sessions = map[string]bool{}
+}
+
+// hasSession reports whether token is a session that is still open.
+func (s *fakeServer) hasSession(token string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sessions[token]
+}
+
+// zone returns the zone with the given name, or nil.
+func (s *fakeServer) zone(name string) *fakeZone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.zones[fakeDomain(name)]
+}
+
+func (s *fakeServer) login(params url.Values, _ string) (interface{}, error) {
+	username := strings.ToLower(params.Get("user"))
+	if password, ok := s.users[username]; !ok || password != params.Get("pass") {
+		return nil, fmt.Errorf("Invalid username or password for user: %s", params.Get("user"))
+	}
+
+	s.nextToken++
+	token := "fake-session-" + strconv.Itoa(s.nextToken)
+	s.tokens[token] = username
+	s.sessions[token] = true
+
+	return s.sessionAnswer(username, token, params.Get("includeInfo") == "true"), nil
+}
+
+func (s *fakeServer) logout(params url.Values, _ string) (interface{}, error) {
+	token := params.Get("token")
+	if s.sessions[token] {
+		delete(s.tokens, token)
+		delete(s.sessions, token)
+	}
+	return fakeTopLevel{}, nil
+}
+
+func (s *fakeServer) session(params url.Values, username string) (interface{}, error) {
+	return s.sessionAnswer(username, params.Get("token"), true), nil
+}
+
+func (s *fakeServer) sessionAnswer(username string, token string, includeInfo bool) fakeTopLevel {
+	answer := fakeTopLevel{
+		"displayName": "Administrator",
+		"username":    username,
+		"token":       token,
+	}
+	if includeInfo {
+		answer["info"] = map[string]interface{}{
+			"version":          s.version,
+			"dnsServerDomain":  "dns.fake",
+			"defaultRecordTtl": fakeTTL,
+		}
+	}
+	return answer
+}
+
+func (s *fakeServer) getSettings(_ url.Values, _ string) (interface{}, error) {
+	settings := make(map[string]interface{}, len(s.settings)+1)
+	for key, value := range s.settings {
+		settings[key] = value
+	}
+	settings["version"] = s.version
+	return settings, nil
+}
+
+func (s *fakeServer) listZones(_ url.Values, _ string) (interface{}, error) {
+	names := make([]string, 0, len(s.zones))
+	for name := range s.zones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	zones := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		zones = append(zones, s.zones[name].info())
+	}
+
+	return map[string]interface{}{
+		"pageNumber": 1,
+		"totalPages": 1,
+		"totalZones": len(zones),
+		"zones":      zones,
+	}, nil
+}
+
+var fakeZoneTypes = []string{"Primary", "Secondary", "Stub", "Forwarder", "SecondaryForwarder", "Catalog", "SecondaryCatalog"}
+
+func (s *fakeServer) createZone(params url.Values, _ string) (interface{}, error) {
+	name, err := fakeParam(params, "zone")
+	if err != nil {
+		return nil, err
+	}
+	name = fakeDomain(name)
+
+	zoneType := "Primary"
+	if value := params.Get("type"); value != "" {
+		zoneType = ""
+		for _, known := range fakeZoneTypes {
+			if strings.EqualFold(value, known) {
+				zoneType = known
+			}
+		}
+		if zoneType == "" {
+			return nil, fmt.Errorf("Requested value '%s' was not found.", value)
+		}
+	}
+
+	if _, ok := s.zones[name]; ok {
+		return nil, fmt.Errorf("Zone already exists: %s", name)
+	}
+
+	zone := &fakeZone{
+		name:         name,
+		zoneType:     zoneType,
+		serial:       1,
+		lastModified: "2024-01-01T00:00:00Z",
+	}
+	if zoneType == "Primary" {
+		zone.records = []*fakeRecord{
+			{name: name, rrType: "SOA", ttl: 900, rData: map[string]interface{}{
+				"primaryNameServer": "dns.fake",
+				"responsiblePerson": "hostadmin@dns.fake",
+				"serial":            1,
+			}},
+			{name: name, rrType: "NS", ttl: fakeTTL, rData: map[string]interface{}{
+				"nameServer": "dns.fake",
+			}},
+		}
+	}
+	s.zones[name] = zone
+
+	return map[string]interface{}{"domain": name}, nil
+}
+
+func (s *fakeServer) deleteZone(params url.Values, _ string) (interface{}, error) {
+	zone, err := s.paramZone(params, "zone")
+	if err != nil {
+		return nil, err
+	}
+	delete(s.zones, zone.name)
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) enableZone(params url.Values, _ string) (interface{}, error) {
+	zone, err := s.paramZone(params, "zone")
+	if err != nil {
+		return nil, err
+	}
+	zone.disabled = false
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) disableZone(params url.Values, _ string) (interface{}, error) {
+	zone, err := s.paramZone(params, "zone")
+	if err != nil {
+		return nil, err
+	}
+	zone.disabled = true
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) addRecord(params url.Values, _ string) (interface{}, error) {
+	zone, domain, rrType, err := s.recordTarget(params)
+	if err != nil {
+		return nil, err
+	}
+	rData, err := fakeRData(rrType, params, "")
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := fakeTTLParam(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if rrType == "CNAME" && domain == zone.name {
+		return nil, errors.New("Cannot add CNAME record to zone root.")
+	}
+	for _, record := range zone.recordsAt(domain) {
+		if (rrType == "CNAME") != (record.rrType == "CNAME") {
+			return nil, fmt.Errorf("Cannot add record: a CNAME record and other records cannot exist for the same domain name: %s", domain)
+		}
+	}
+
+	if params.Get("overwrite") == "true" {
+		zone.removeRecords(func(record *fakeRecord) bool {
+			return record.name == domain && record.rrType == rrType
+		})
+	}
+
+	record := &fakeRecord{
+		name:     domain,
+		rrType:   rrType,
+		ttl:      ttl,
+		rData:    rData,
+		comments: params.Get("comments"),
+	}
+	if existing := zone.find(domain, rrType, rData); existing != nil {
+		// Adding a record that already exists only updates its TTL.
+		existing.ttl = ttl
+		record = existing
+	} else {
+		zone.records = append(zone.records, record)
+	}
+	zone.serial++
+
+	return map[string]interface{}{
+		"zone":        zone.info(),
+		"addedRecord": record.info(),
+	}, nil
+}
+
+func (s *fakeServer) getRecords(params url.Values, _ string) (interface{}, error) {
+	domain, err := fakeParam(params, "domain")
+	if err != nil {
+		return nil, err
+	}
+	domain = fakeDomain(domain)
+
+	zone, err := s.authoritativeZone(params.Get("zone"), domain)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]interface{}, 0)
+	for _, record := range zone.records {
+		if params.Get("listZone") == "true" || record.name == domain {
+			records = append(records, record.info())
+		}
+	}
+
+	return map[string]interface{}{
+		"zone":    zone.info(),
+		"records": records,
+	}, nil
+}
+
+func (s *fakeServer) updateRecord(params url.Values, _ string) (interface{}, error) {
+	zone, domain, rrType, err := s.recordTarget(params)
+	if err != nil {
+		return nil, err
+	}
+	rData, err := fakeRData(rrType, params, "")
+	if err != nil {
+		return nil, err
+	}
+	record := zone.find(domain, rrType, rData)
+	if record == nil {
+		return nil, errors.New("Cannot update record: the record does not exist.")
+	}
+
+	newDomain := domain
+	if value := params.Get("newDomain"); value != "" {
+		newDomain = fakeDomain(value)
+		if !fakeInZone(newDomain, zone.name) {
+			return nil, fmt.Errorf("The domain name does not belong to the zone: %s", newDomain)
+		}
+	}
+	newRData, err := fakeRData(rrType, params, "new")
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := fakeTTLParam(params)
+	if err != nil {
+		return nil, err
+	}
+
+	record.name = newDomain
+	record.rData = newRData
+	record.ttl = ttl
+	record.disabled = params.Get("disable") == "true"
+	if params.Has("comments") {
+		record.comments = params.Get("comments")
+	}
+	zone.serial++
+
+	return map[string]interface{}{
+		"zone":          zone.info(),
+		"updatedRecord": record.info(),
+	}, nil
+}
+
+func (s *fakeServer) deleteRecord(params url.Values, _ string) (interface{}, error) {
+	zone, domain, rrType, err := s.recordTarget(params)
+	if err != nil {
+		return nil, err
+	}
+	rData, err := fakeRData(rrType, params, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleting a record that does not exist succeeds, like on the server.
+	if record := zone.find(domain, rrType, rData); record != nil {
+		zone.removeRecords(func(candidate *fakeRecord) bool {
+			return candidate == record
+		})
+		zone.serial++
+	}
+
+	return map[string]interface{}{}, nil
+}
+
+// recordTarget resolves the zone, domain and type parameters of a record
+// call.
+func (s *fakeServer) recordTarget(params url.Values) (*fakeZone, string, string, error) {
+	domain, err := fakeParam(params, "domain")
+	if err != nil {
+		return nil, "", "", err
+	}
+	domain = fakeDomain(domain)
+
+	rrType, err := fakeParam(params, "type")
+	if err != nil {
+		return nil, "", "", err
+	}
+	rrType = strings.ToUpper(rrType)
+
+	zone, err := s.authoritativeZone(params.Get("zone"), domain)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return zone, domain, rrType, nil
+}
+
+// authoritativeZone returns the named zone, or the closest zone containing
+// domain when no zone is given.
+func (s *fakeServer) authoritativeZone(name string, domain string) (*fakeZone, error) {
+	if name != "" {
+		zone, ok := s.zones[fakeDomain(name)]
+		if !ok {
+			return nil, fmt.Errorf("No such zone was found: %s", name)
+		}
+		if !fakeInZone(domain, zone.name) {
+			return nil, fmt.Errorf("The domain name does not belong to the zone: %s", domain)
+		}
+		return zone, nil
+	}
+
+	for candidate := domain; candidate != ""; {
+		if zone, ok := s.zones[candidate]; ok {
+			return zone, nil
+		}
+		_, parent, found := strings.Cut(candidate, ".")
+		if !found {
+			break
+		}
+		candidate = parent
+	}
+	return nil, fmt.Errorf("No authoritative zone was found for the domain: %s", domain)
+}
+
+func (s *fakeServer) paramZone(params url.Values, key string) (*fakeZone, error) {
+	name, err := fakeParam(params, key)
+	if err != nil {
+		return nil, err
+	}
+	zone, ok := s.zones[fakeDomain(name)]
+	if !ok {
+		return nil, fmt.Errorf("No such zone was found: %s", name)
+	}
+	return zone, nil
+}
+
+func (z *fakeZone) info() map[string]interface{} {
+	return map[string]interface{}{
+		"name":         z.name,
+		"type":         z.zoneType,
+		"internal":     false,
+		"dnssecStatus": "Unsigned",
+		"soaSerial":    z.serial,
+		"lastModified": z.lastModified,
+		"disabled":     z.disabled,
+	}
+}
+
+func (z *fakeZone) recordsAt(domain string) []*fakeRecord {
+	var records []*fakeRecord
+	for _, record := range z.records {
+		if record.name == domain {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func (z *fakeZone) find(domain string, rrType string, rData map[string]interface{}) *fakeRecord {
+	for _, record := range z.records {
+		if record.name == domain && record.rrType == rrType && fmt.Sprint(record.rData) == fmt.Sprint(rData) {
+			return record
+		}
+	}
+	return nil
+}
+
+func (z *fakeZone) removeRecords(match func(*fakeRecord) bool) {
+	kept := z.records[:0]
+	for _, record := range z.records {
+		if !match(record) {
+			kept = append(kept, record)
+		}
+	}
+	z.records = kept
+}
+
+func (r *fakeRecord) info() map[string]interface{} {
+	return map[string]interface{}{
+		"disabled":     r.disabled,
+		"name":         r.name,
+		"type":         r.rrType,
+		"ttl":          r.ttl,
+		"rData":        r.rData,
+		"dnssecStatus": "Unknown",
+		"comments":     r.comments,
+		"lastUsedOn":   "0001-01-01T00:00:00",
+	}
+}
+
+// fakeRData reads the record data parameters of a record type. prefix is
+// "new" for the replacement values of an update.
+func fakeRData(rrType string, params url.Values, prefix string) (map[string]interface{}, error) {
+	key := func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		return prefix + strings.ToUpper(name[:1]) + name[1:]
+	}
+	value := func(name string) (string, error) {
+		// Update calls fall back to the current value when no new one is
+		// given.
+		if prefix != "" && !params.Has(key(name)) {
+			return fakeParam(params, name)
+		}
+		return fakeParam(params, key(name))
+	}
+
+	switch rrType {
+	case "A", "AAAA":
+		address, err := value("ipAddress")
+		if err != nil {
+			return nil, err
+		}
+		ip := net.ParseIP(address)
+		if ip == nil || (ip.To4() != nil) != (rrType == "A") {
+			return nil, fmt.Errorf("An invalid IP address was specified: %s", address)
+		}
+		return map[string]interface{}{"ipAddress": ip.String()}, nil
+	case "CNAME":
+		cname, err := value("cname")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"cname": fakeDomain(cname)}, nil
+	case "TXT":
+		text, err := value("text")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"text": text}, nil
+	default:
+		return nil, fmt.Errorf("The fake server does not support %s records.", rrType)
+	}
+}
+
+func fakeTTLParam(params url.Values) (int64, error) {
+	if !params.Has("ttl") {
+		return fakeTTL, nil
+	}
+	ttl, err := strconv.ParseInt(params.Get("ttl"), 10, 32)
+	if err != nil || ttl < 0 {
+		return 0, fmt.Errorf("Invalid TTL value: %s", params.Get("ttl"))
+	}
+	return ttl, nil
+}
+
+func fakeParam(params url.Values, key string) (string, error) {
+	value := params.Get(key)
+	if value == "" {
+		return "", fmt.Errorf("Parameter '%s' missing.", key)
+	}
+	return value, nil
+}
+
+func fakeDomain(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+func fakeInZone(domain string, zone string) bool {
+	return domain == zone || strings.HasSuffix(domain, "."+zone)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+)
+
+// fakeSettingKinds maps each settings parameter to the type of the field the
+// client decodes it into, so the set call can parse it the way the server
+// does.
+var fakeSettingKinds = func() map[string]reflect.Type {
+	kinds := map[string]reflect.Type{}
+	settings := reflect.TypeOf(technitium.DnsSettings{})
+	for i := 0; i < settings.NumField(); i++ {
+		field := settings.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		kinds[name] = field.Type
+	}
+	return kinds
+}()
+
+func (s *fakeServer) setSettings(params url.Values, username string) (interface{}, error) {
+	// Parse every setting before changing any, so that a bad value leaves
+	// the settings untouched.
+	settings := map[string]interface{}{}
+	for key := range params {
+		kind, ok := fakeSettingKinds[key]
+		if !ok {
+			continue
+		}
+		value := params.Get(key)
+		switch kind {
+		case reflect.TypeOf([]string{}):
+			settings[key] = fakeList(value)
+		case reflect.TypeOf((*bool)(nil)):
+			if value != "true" && value != "false" {
+				return nil, fmt.Errorf("Invalid value for %s: %s", key, value)
+			}
+			settings[key] = value == "true"
+		case reflect.TypeOf((*int32)(nil)), reflect.TypeOf((*int64)(nil)):
+			number, err := strconv.Atoi(value)
+			if err != nil || number < 0 {
+				return nil, fmt.Errorf("Invalid value for %s: %s", key, value)
+			}
+			settings[key] = number
+		default:
+			settings[key] = value
+		}
+	}
+
+	for key, value := range settings {
+		s.settings[key] = value
+	}
+	// Enabling blocking ends a temporary disable.
+	if settings["enableBlocking"] == true {
+		delete(s.settings, "temporaryDisableBlockingTill")
+	}
+	return s.getSettings(params, username)
+}
+
+// setting returns the current value of a server setting.
+func (s *fakeServer) setting(key string) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.settings[key]
+}
+
+func (s *fakeServer) flushCache(_ url.Values, _ string) (interface{}, error) {
+	s.flushes = append(s.flushes, ".")
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) deleteCachedZone(params url.Values, _ string) (interface{}, error) {
+	domain, err := fakeParam(params, "domain")
+	if err != nil {
+		return nil, err
+	}
+	s.flushes = append(s.flushes, domain)
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) forceUpdateBlockLists(_ url.Values, _ string) (interface{}, error) {
+	s.blockListUpdates++
+	return map[string]interface{}{}, nil
+}
+
+func (s *fakeServer) temporaryDisableBlocking(params url.Values, _ string) (interface{}, error) {
+	minutes, err := strconv.Atoi(params.Get("minutes"))
+	if err != nil || minutes < 1 {
+		return nil, fmt.Errorf("Invalid value for minutes: %s", params.Get("minutes"))
+	}
+	till := time.Now().Add(time.Duration(minutes) * time.Minute).UTC().Format(time.RFC3339)
+	s.settings["enableBlocking"] = false
+	s.settings["temporaryDisableBlockingTill"] = till
+	return map[string]interface{}{"temporaryDisableBlockingTill": till}, nil
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &forwardersResource{}
+	_ resource.ResourceWithConfigure      = &forwardersResource{}
+	_ resource.ResourceWithValidateConfig = &forwardersResource{}
+)
+
+// NewForwardersResource is a helper function to simplify the provider implementation.
+func NewForwardersResource() resource.Resource {
+	return &forwardersResource{
+		settingsResource: settingsResource[forwardersResourceModel]{
+			id:      "forwarders",
+			name:    "forwarders",
+			set:     setForwarders,
+			refresh: refreshForwarders,
+		},
+	}
+}
+
+// forwardersResource manages the upstream servers the DNS server forwards
+// queries to, and how it talks to them.
+type forwardersResource struct {
+	settingsResource[forwardersResourceModel]
+}
+
+// forwardersResourceModel maps the resource schema data.
+type forwardersResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	Forwarders           types.List   `tfsdk:"forwarders"`
+	ForwarderProtocol    types.String `tfsdk:"forwarder_protocol"`
+	ConcurrentForwarding types.Bool   `tfsdk:"concurrent_forwarding"`
+	ForwarderRetries     types.Int32  `tfsdk:"forwarder_retries"`
+	ForwarderTimeout     types.Int32  `tfsdk:"forwarder_timeout"`
+	ForwarderConcurrency types.Int32  `tfsdk:"forwarder_concurrency"`
+}
+
+// Metadata returns the resource type name.
+func (r *forwardersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forwarders"
+}
+
+// Schema defines the schema for the resource.
+func (r *forwardersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	forwarders := settingList()
+	forwarders.Optional = false
+	forwarders.Required = true
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"forwarders": forwarders,
+			"forwarder_protocol": settingString(
+				stringvalidator.OneOf("Udp", "Tcp", "Tls", "Https", "Quic"),
+			),
+			"concurrent_forwarding": settingBool(),
+			"forwarder_retries":     settingInt32(int32validator.Between(1, 10)),
+			"forwarder_timeout":     settingInt32(int32validator.Between(1000, 10000)),
+			"forwarder_concurrency": settingInt32(int32validator.Between(1, 10)),
+		},
+	}
+}
+
+// ValidateConfig checks that the forwarders are written the way the
+// protocol expects: URLs for DNS-over-HTTPS, addresses or host names
+// otherwise.
+func (r *forwardersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config forwardersResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ForwarderProtocol.IsNull() || config.ForwarderProtocol.IsUnknown() || config.Forwarders.IsUnknown() {
+		return
+	}
+
+	var forwarders []types.String
+	resp.Diagnostics.Append(config.Forwarders.ElementsAs(ctx, &forwarders, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protocol := config.ForwarderProtocol.ValueString()
+	for i, forwarder := range forwarders {
+		if forwarder.IsUnknown() || forwarder.IsNull() {
+			continue
+		}
+		isURL := strings.Contains(forwarder.ValueString(), "://")
+		switch {
+		case protocol == "Https" && !strings.HasPrefix(forwarder.ValueString(), "https://"):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("forwarders").AtListIndex(i),
+				"Invalid Forwarder",
+				fmt.Sprintf("The Https forwarder protocol expects https:// URLs, got: %s", forwarder.ValueString()),
+			)
+		case protocol != "Https" && isURL:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("forwarders").AtListIndex(i),
+				"Invalid Forwarder",
+				fmt.Sprintf("The %s forwarder protocol expects an address or host name, got: %s", protocol, forwarder.ValueString()),
+			)
+		}
+	}
+}
+
+// setForwarders adds the declared forwarders to a set settings request.
+func setForwarders(ctx context.Context, settings technitium.ApiSetSettingsRequest, plan forwardersResourceModel, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+	settings = settings.Forwarders(stringListElements(ctx, plan.Forwarders, diags))
+	if !plan.ForwarderProtocol.IsNull() {
+		settings = settings.ForwarderProtocol(plan.ForwarderProtocol.ValueString())
+	}
+	if !plan.ConcurrentForwarding.IsNull() {
+		settings = settings.ConcurrentForwarding(plan.ConcurrentForwarding.ValueBool())
+	}
+	if !plan.ForwarderRetries.IsNull() {
+		settings = settings.ForwarderRetries(plan.ForwarderRetries.ValueInt32())
+	}
+	if !plan.ForwarderTimeout.IsNull() {
+		settings = settings.ForwarderTimeout(plan.ForwarderTimeout.ValueInt32())
+	}
+	if !plan.ForwarderConcurrency.IsNull() {
+		settings = settings.ForwarderConcurrency(plan.ForwarderConcurrency.ValueInt32())
+	}
+	return settings
+}
+
+// refreshForwarders copies the managed forwarder settings from the server.
+func refreshForwarders(ctx context.Context, model *forwardersResourceModel, settings *technitium.DnsSettings, diags *diag.Diagnostics) {
+	model.Forwarders = refreshList(ctx, model.Forwarders, settings.Forwarders, diags)
+	model.ForwarderProtocol = refreshString(model.ForwarderProtocol, settings.ForwarderProtocol)
+	model.ConcurrentForwarding = refreshBool(model.ConcurrentForwarding, settings.ConcurrentForwarding)
+	model.ForwarderRetries = refreshInt32(model.ForwarderRetries, settings.ForwarderRetries)
+	model.ForwarderTimeout = refreshInt32(model.ForwarderTimeout, settings.ForwarderTimeout)
+	model.ForwarderConcurrency = refreshInt32(model.ForwarderConcurrency, settings.ForwarderConcurrency)
+}
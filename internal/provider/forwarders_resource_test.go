@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccForwardersResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccForwardersResourceConfig(`
+  forwarders = ["1.1.1.1", "8.8.8.8:53"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_forwarders.test", "id", "forwarders"),
+					resource.TestCheckResourceAttr("technitium_forwarders.test", "forwarders.#", "2"),
+					resource.TestCheckResourceAttr("technitium_forwarders.test", "forwarders.1", "8.8.8.8:53"),
+					resource.TestCheckNoResourceAttr("technitium_forwarders.test", "forwarder_protocol"),
+					testAccCheckDnsSetting(server, "forwarders", []string{"1.1.1.1", "8.8.8.8:53"}),
+					testAccCheckDnsSetting(server, "forwarderProtocol", "Udp"),
+				),
+			},
+			// Update and Read testing, switching to DNS-over-HTTPS
+			{
+				Config: testAccProviderConfig(server) + testAccForwardersResourceConfig(`
+  forwarders            = ["https://cloudflare-dns.com/dns-query (1.1.1.1)"]
+  forwarder_protocol    = "Https"
+  concurrent_forwarding = false
+  forwarder_retries     = 2
+  forwarder_timeout     = 5000
+  forwarder_concurrency = 1
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_forwarders.test", "forwarders.#", "1"),
+					resource.TestCheckResourceAttr("technitium_forwarders.test", "forwarder_protocol", "Https"),
+					resource.TestCheckResourceAttr("technitium_forwarders.test", "concurrent_forwarding", "false"),
+					resource.TestCheckResourceAttr("technitium_forwarders.test", "forwarder_timeout", "5000"),
+					testAccCheckDnsSetting(server, "forwarderProtocol", "Https"),
+					testAccCheckDnsSetting(server, "concurrentForwarding", false),
+					testAccCheckDnsSetting(server, "forwarderRetries", 2),
+					testAccCheckDnsSetting(server, "forwarderTimeout", 5000),
+					testAccCheckDnsSetting(server, "forwarderConcurrency", 1),
+				),
+			},
+			// Forwarders changed outside of Terraform are detected and reverted
+			testAccSettingsDriftStep(server, testAccProviderConfig(server)+testAccForwardersResourceConfig(`
+  forwarders            = ["https://cloudflare-dns.com/dns-query (1.1.1.1)"]
+  forwarder_protocol    = "Https"
+  concurrent_forwarding = false
+  forwarder_retries     = 2
+  forwarder_timeout     = 5000
+  forwarder_concurrency = 1
+`), []settingDrift{
+				{key: "forwarders", drifted: []string{"9.9.9.9"}, want: []string{"https://cloudflare-dns.com/dns-query (1.1.1.1)"}},
+				{key: "forwarderProtocol", drifted: "Udp", want: "Https"},
+			}),
+			// A setting the server leaves out keeps its value
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					delete(server.settings, "forwarderConcurrency")
+				},
+				Config: testAccProviderConfig(server) + testAccForwardersResourceConfig(`
+  forwarders            = ["https://cloudflare-dns.com/dns-query (1.1.1.1)"]
+  forwarder_protocol    = "Https"
+  concurrent_forwarding = false
+  forwarder_retries     = 2
+  forwarder_timeout     = 5000
+  forwarder_concurrency = 1
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("technitium_forwarders.test", "forwarder_concurrency", "1"),
+			},
+			// An empty list removes the forwarders
+			{
+				Config: testAccProviderConfig(server) + testAccForwardersResourceConfig(`
+  forwarders = []
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_forwarders.test", "forwarders.#", "0"),
+					testAccCheckDnsSetting(server, "forwarders", []string{}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccForwardersResource_invalidForwarder(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccForwardersResourceConfig(`
+  forwarders         = ["1.1.1.1"]
+  forwarder_protocol = "Https"
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The\s+Https\s+forwarder\s+protocol\s+expects\s+https://\s+URLs`),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccForwardersResourceConfig(`
+  forwarders         = ["https://dns.google/dns-query"]
+  forwarder_protocol = "Tls"
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The\s+Tls\s+forwarder\s+protocol\s+expects\s+an\s+address\s+or\s+host\s+name`),
+			},
+		},
+	})
+}
+
+func testAccForwardersResourceConfig(settings string) string {
+	return fmt.Sprintf(`
+resource "technitium_forwarders" "test" {%s}
+`, settings)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = jsonType{}
+	_ basetypes.StringValuableWithSemanticEquals = jsonValue{}
+)
+
+// jsonType is the type of string attributes holding a JSON document. Its
+// values are compared as JSON, so the server reformatting a document does
+// not show as a change.
+type jsonType struct {
+	basetypes.StringType
+}
+
+func (t jsonType) String() string {
+	return "jsonType"
+}
+
+func (t jsonType) ValueType(_ context.Context) attr.Value {
+	return jsonValue{}
+}
+
+func (t jsonType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return jsonValue{StringValue: in}, nil
+}
+
+func (t jsonType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return jsonValue{StringValue: stringValue}, nil
+}
+
+// jsonValue is a value of jsonType.
+type jsonValue struct {
+	basetypes.StringValue
+}
+
+// jsonStringValue returns a known JSON value.
+func jsonStringValue(value string) jsonValue {
+	return jsonValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v jsonValue) Type(_ context.Context) attr.Type {
+	return jsonType{}
+}
+
+func (v jsonValue) Equal(o attr.Value) bool {
+	other, ok := o.(jsonValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals keeps the prior value when the new value holds the
+// same JSON document.
+func (v jsonValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(jsonValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+	return jsonEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// jsonEqual reports whether two strings hold the same JSON document,
+// ignoring whitespace and the order of object keys. Numbers are compared as
+// written, so large integers that would round to the same float still
+// differ. Strings that are not JSON are never equal.
+func jsonEqual(a string, b string) bool {
+	va, errA := decodeJSON(a)
+	vb, errB := decodeJSON(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// decodeJSON decodes a single JSON document, keeping numbers as
+// json.Number.
+func decodeJSON(s string) (interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(s))
+	decoder.UseNumber()
+
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("unexpected data after the JSON document")
+	}
+	return v, nil
+}
@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestJSONEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: `{"a":1,"b":[true,null]}`, b: "{\n  \"b\": [true, null],\n  \"a\": 1\n}", want: true},
+		{a: `{"a":1}`, b: `{"a":2}`, want: false},
+		{a: `[1,2]`, b: `[2,1]`, want: false},
+		{a: `{"id":9007199254740993}`, b: `{"id":9007199254740992}`, want: false},
+		{a: `{"id":9007199254740993}`, b: `{ "id": 9007199254740993 }`, want: true},
+		{a: `{"a":1}`, b: `{"a":1} {}`, want: false},
+		{a: `not json`, b: `not json`, want: false},
+	}
+
+	for _, test := range tests {
+		if got := jsonEqual(test.a, test.b); got != test.want {
+			t.Errorf("jsonEqual(%q, %q) = %t", test.a, test.b, got)
+		}
+	}
+}
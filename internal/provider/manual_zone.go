@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+)
+
+// manualZone is the allowed or the blocked zone, the domains added by hand
+// to always allow or always block, next to the ones from the block lists.
+type manualZone string
+
+const (
+	allowedZone manualZone = "allowed"
+	blockedZone manualZone = "blocked"
+)
+
+// manualZoneDomainPattern matches a domain name without the trailing dot,
+// the way the server lists it.
+var manualZoneDomainPattern = regexp.MustCompile(`^[^.\s]+(\.[^.\s]+)*$`)
+
+// kind describes the zone entries in diagnostics.
+func (z manualZone) kind() string {
+	return string(z) + " zone"
+}
+
+// contains reports whether the domain was added to the zone. A domain that
+// was not added can still show up as the parent of one that was, but only
+// added domains carry records.
+func (z manualZone) contains(ctx context.Context, client *technitiumClient, domain string) (bool, error) {
+	var (
+		answ *technitium.ListManualZonesResponse
+		err  error
+	)
+	if z == allowedZone {
+		answ, _, err = client.AllowedAPI.ListAllowedZones(ctx).Domain(domain).Execute()
+	} else {
+		answ, _, err = client.BlockedAPI.ListBlockedZones(ctx).Domain(domain).Execute()
+	}
+	if err = checkResponse(answ, err); err != nil {
+		return false, err
+	}
+
+	listed := answ.GetResponse()
+	return strings.EqualFold(listed.GetDomain(), domain) && len(listed.Records) > 0, nil
+}
+
+// domains returns the domains added to the zone, in lower case.
+func (z manualZone) domains(ctx context.Context, client *technitiumClient) (map[string]bool, error) {
+	var (
+		body []byte
+		err  error
+	)
+	if z == allowedZone {
+		body, _, err = client.AllowedAPI.ExportAllowedZones(ctx).Execute()
+	} else {
+		body, _, err = client.BlockedAPI.ExportBlockedZones(ctx).Execute()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Failures are answered with the usual JSON status instead of the file.
+	var status technitium.StatusResponse
+	if json.Unmarshal(body, &status) == nil {
+		if err := checkResponse(&status, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	domains := map[string]bool{}
+	for _, line := range strings.Split(string(body), "\n") {
+		if domain := strings.TrimSpace(line); domain != "" {
+			domains[strings.ToLower(domain)] = true
+		}
+	}
+	return domains, nil
+}
+
+// add adds the domain to the zone.
+func (z manualZone) add(ctx context.Context, client *technitiumClient, domain string) error {
+	if z == allowedZone {
+		answ, _, err := client.AllowedAPI.AddAllowedZone(ctx).Domain(domain).Execute()
+		return checkResponse(answ, err)
+	}
+	answ, _, err := client.BlockedAPI.AddBlockedZone(ctx).Domain(domain).Execute()
+	return checkResponse(answ, err)
+}
+
+// remove removes the domain from the zone.
+func (z manualZone) remove(ctx context.Context, client *technitiumClient, domain string) error {
+	if z == allowedZone {
+		answ, _, err := client.AllowedAPI.DeleteAllowedZone(ctx).Domain(domain).Execute()
+		return checkResponse(answ, err)
+	}
+	answ, _, err := client.BlockedAPI.DeleteBlockedZone(ctx).Domain(domain).Execute()
+	return checkResponse(answ, err)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBlockedZoneResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckManualZone(server, blockedZone, "ads.example.com", false),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccManualZoneResourceConfig(blockedZone, "ads.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_blocked_zone.test", "id", "ads.example.com"),
+					resource.TestCheckResourceAttr("technitium_blocked_zone.test", "domain", "ads.example.com"),
+					testAccCheckManualZone(server, blockedZone, "ads.example.com", true),
+					testAccCheckManualZone(server, allowedZone, "ads.example.com", false),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "technitium_blocked_zone.test",
+				ImportState:       true,
+				ImportStateId:     "ads.example.com",
+				ImportStateVerify: true,
+			},
+			// Replace testing
+			{
+				Config: testAccProviderConfig(server) + testAccManualZoneResourceConfig(blockedZone, "tracker.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckManualZone(server, blockedZone, "tracker.example.com", true),
+					testAccCheckManualZone(server, blockedZone, "ads.example.com", false),
+				),
+			},
+			// A domain removed outside of Terraform is added again. A
+			// domain below it does not count.
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					delete(server.blocked, "tracker.example.com")
+					server.blocked["cdn.tracker.example.com"] = true
+				},
+				Config: testAccProviderConfig(server) + testAccManualZoneResourceConfig(blockedZone, "tracker.example.com"),
+				Check:  testAccCheckManualZone(server, blockedZone, "tracker.example.com", true),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAllowedZoneResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckManualZone(server, allowedZone, "login.example.com", false),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccManualZoneResourceConfig(allowedZone, "login.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_allowed_zone.test", "id", "login.example.com"),
+					testAccCheckManualZone(server, allowedZone, "login.example.com", true),
+					testAccCheckManualZone(server, blockedZone, "login.example.com", false),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "technitium_allowed_zone.test",
+				ImportState:       true,
+				ImportStateId:     "login.example.com",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccBlockedZoneResource_invalidDomain(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig(server) + testAccManualZoneResourceConfig(blockedZone, "ads.example.com."),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must\s+be\s+a\s+domain\s+name\s+without\s+the\s+trailing\s+dot`),
+			},
+		},
+	})
+}
+
+func testAccManualZoneResourceConfig(zone manualZone, domain string) string {
+	return fmt.Sprintf(`
+resource "technitium_%s_zone" "test" {
+  domain = %q
+}
+`, zone, domain)
+}
+
+func testAccCheckManualZone(server *fakeServer, zone manualZone, domain string, expected bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		entries := server.blocked
+		if zone == allowedZone {
+			entries = server.allowed
+		}
+		if entries[domain] != expected {
+			return fmt.Errorf("%s in the %s is %t, expected %t", domain, zone.kind(), entries[domain], expected)
+		}
+		return nil
+	}
+}
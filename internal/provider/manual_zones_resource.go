@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &manualZonesResource{}
+	_ resource.ResourceWithConfigure   = &manualZonesResource{}
+	_ resource.ResourceWithImportState = &manualZonesResource{}
+)
+
+// NewAllowedZonesResource is a helper function to simplify the provider implementation.
+func NewAllowedZonesResource() resource.Resource {
+	return &manualZonesResource{zone: allowedZone}
+}
+
+// NewBlockedZonesResource is a helper function to simplify the provider implementation.
+func NewBlockedZonesResource() resource.Resource {
+	return &manualZonesResource{zone: blockedZone}
+}
+
+// manualZonesResource manages a set of domains of the allowed or the blocked
+// zone. Domains of the zone that are not in the set are left alone.
+type manualZonesResource struct {
+	client *technitiumClient
+	zone   manualZone
+}
+
+// manualZonesResourceModel maps the resource schema data.
+type manualZonesResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	Domains types.Set    `tfsdk:"domains"`
+}
+
+// Metadata returns the resource type name.
+func (r *manualZonesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + string(r.zone) + "_zones"
+}
+
+// Schema defines the schema for the resource.
+func (r *manualZonesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domains": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(manualZoneDomainPattern, "must be a domain name without the trailing dot"),
+					),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *manualZonesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan manualZonesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add the domains to the zone, keeping the ones added when one fails
+	planned := stringSetElements(ctx, plan.Domains, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(string(r.zone))
+	plan.Domains = r.apply(ctx, nil, planned, &resp.Diagnostics)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *manualZonesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state manualZonesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed domains from Technitium. Domains removed outside of
+	// Terraform drop out of the set, which may leave it empty, so that the
+	// plan adds them again.
+	listed, err := r.zone.domains(ctx, r.client)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "read", r.zone.kind(), "", err)
+		return
+	}
+	domains := []string{}
+	for _, domain := range stringSetElements(ctx, state.Domains, &resp.Diagnostics) {
+		if listed[strings.ToLower(domain)] {
+			domains = append(domains, domain)
+		}
+	}
+	state.ID = types.StringValue(string(r.zone))
+	state.Domains = stringSetValue(ctx, domains, &resp.Diagnostics)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update adds and removes the domains that changed and sets the updated
+// Terraform state.
+func (r *manualZonesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state manualZonesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := stringSetElements(ctx, state.Domains, &resp.Diagnostics)
+	planned := stringSetElements(ctx, plan.Domains, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Domains = r.apply(ctx, current, planned, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *manualZonesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state manualZonesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the domains from the zone
+	for _, domain := range stringSetElements(ctx, state.Domains, &resp.Diagnostics) {
+		if err := r.zone.remove(ctx, r.client, domain); err != nil {
+			addAPIError(&resp.Diagnostics, "delete", r.zone.kind(), domain, err)
+			return
+		}
+	}
+}
+
+// ImportState imports existing entries by their domains, separated by
+// commas.
+func (r *manualZonesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var domains []string
+	for _, domain := range strings.Split(req.ID, ",") {
+		if domain = strings.TrimSpace(domain); domain != "" {
+			domains = append(domains, domain)
+		}
+	}
+	if len(domains) == 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: domain,domain,... Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domains"), domains)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *manualZonesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// apply removes the current domains that are not planned and adds the
+// planned domains that are not current. It returns the domains of the zone
+// afterwards, which fall short of the planned ones when a call fails.
+func (r *manualZonesResource) apply(ctx context.Context, current []string, planned []string, diags *diag.Diagnostics) types.Set {
+	domains := map[string]bool{}
+	for _, domain := range current {
+		domains[domain] = true
+	}
+	wanted := map[string]bool{}
+	for _, domain := range planned {
+		wanted[domain] = true
+	}
+
+	for _, domain := range current {
+		if wanted[domain] {
+			continue
+		}
+		if err := r.zone.remove(ctx, r.client, domain); err != nil {
+			addAPIError(diags, "delete", r.zone.kind(), domain, err)
+			break
+		}
+		delete(domains, domain)
+	}
+	if !diags.HasError() {
+		for _, domain := range planned {
+			if domains[domain] {
+				continue
+			}
+			if err := r.zone.add(ctx, r.client, domain); err != nil {
+				addAPIError(diags, "create", r.zone.kind(), domain, err)
+				break
+			}
+			domains[domain] = true
+		}
+	}
+
+	result := make([]string, 0, len(domains))
+	for domain := range domains {
+		result = append(result, domain)
+	}
+	sort.Strings(result)
+	return stringSetValue(ctx, result, diags)
+}
+
+// stringSetElements returns the elements of a set of strings.
+func stringSetElements(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	var values []string
+	diags.Append(set.ElementsAs(ctx, &values, false)...)
+	return values
+}
+
+// stringSetValue returns a set of strings.
+func stringSetValue(ctx context.Context, values []string, diags *diag.Diagnostics) types.Set {
+	set, d := types.SetValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return set
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBlockedZonesResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckManualZone(server, blockedZone, "ads.example.com", false),
+			testAccCheckManualZone(server, blockedZone, "tracker.example.net", false),
+			// Domains added outside of Terraform are left alone
+			testAccCheckManualZone(server, blockedZone, "manual.example.org", true),
+		),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					server.blocked["manual.example.org"] = true
+				},
+				Config: testAccProviderConfig(server) + testAccManualZonesResourceConfig(blockedZone, "ads.example.com", "tracker.example.net"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_blocked_zones.test", "id", "blocked"),
+					resource.TestCheckResourceAttr("technitium_blocked_zones.test", "domains.#", "2"),
+					resource.TestCheckTypeSetElemAttr("technitium_blocked_zones.test", "domains.*", "ads.example.com"),
+					testAccCheckManualZone(server, blockedZone, "ads.example.com", true),
+					testAccCheckManualZone(server, blockedZone, "tracker.example.net", true),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "technitium_blocked_zones.test",
+				ImportState:       true,
+				ImportStateId:     "ads.example.com,tracker.example.net",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccManualZonesResourceConfig(blockedZone, "ads.example.com", "malware.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_blocked_zones.test", "domains.#", "2"),
+					testAccCheckManualZone(server, blockedZone, "ads.example.com", true),
+					testAccCheckManualZone(server, blockedZone, "malware.example.com", true),
+					testAccCheckManualZone(server, blockedZone, "tracker.example.net", false),
+				),
+			},
+			// A domain removed outside of Terraform is added again
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					delete(server.blocked, "malware.example.com")
+				},
+				Config: testAccProviderConfig(server) + testAccManualZonesResourceConfig(blockedZone, "ads.example.com", "malware.example.com"),
+				Check:  testAccCheckManualZone(server, blockedZone, "malware.example.com", true),
+			},
+			// All domains removed outside of Terraform are added again
+			{
+				PreConfig: func() {
+					server.mu.Lock()
+					defer server.mu.Unlock()
+					delete(server.blocked, "ads.example.com")
+					delete(server.blocked, "malware.example.com")
+				},
+				Config: testAccProviderConfig(server) + testAccManualZonesResourceConfig(blockedZone, "ads.example.com", "malware.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_blocked_zones.test", "domains.#", "2"),
+					testAccCheckManualZone(server, blockedZone, "ads.example.com", true),
+					testAccCheckManualZone(server, blockedZone, "malware.example.com", true),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAllowedZonesResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckManualZone(server, allowedZone, "cdn.example.com", false),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccManualZonesResourceConfig(allowedZone, "cdn.example.com", "login.example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_allowed_zones.test", "id", "allowed"),
+					testAccCheckManualZone(server, allowedZone, "cdn.example.com", true),
+					testAccCheckManualZone(server, allowedZone, "login.example.com", true),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccManualZonesResourceConfig(zone manualZone, domains ...string) string {
+	return fmt.Sprintf(`
+resource "technitium_%s_zones" "test" {
+  domains = ["%s"]
+}
+`, zone, strings.Join(domains, `", "`))
+}
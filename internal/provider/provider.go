@@ -2,15 +2,23 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/url"
 	"os"
+	"strings"
 	"terraform-provider-technitium/internal/provider/technitium"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,25 +28,36 @@ var (
 	_ provider.Provider = &technitiumProvider{}
 )
 
-type CustomHTTPClient struct {
-	client *http.Client
-	token  string
-}
+// Ways of sending the API token to the server.
+const (
+	tokenModeAuto   = "auto"
+	tokenModeHeader = "header"
+	tokenModeQuery  = "query"
+)
 
-func (c *CustomHTTPClient) RoundTrip(req *http.Request) (*http.Response, error) {
-	query := req.URL.Query()
-	query.Add("token", c.token)
-	req.URL.RawQuery = query.Encode()
+type technitiumProviderModel struct {
+	Host      types.String `tfsdk:"host"`
+	Endpoints types.List   `tfsdk:"endpoints"`
+	Token     types.String `tfsdk:"token"`
+	Username  types.String `tfsdk:"username"`
+	Password  types.String `tfsdk:"password"`
+	Totp      types.String `tfsdk:"totp"`
+	TokenMode types.String `tfsdk:"token_mode"`
 
-	if c.client.Transport == nil {
-		c.client.Transport = http.DefaultTransport
-	}
-	return c.client.Transport.RoundTrip(req)
-}
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	TLSServerName      types.String `tfsdk:"tls_server_name"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
 
-type technitiumProviderModel struct {
-	Host  types.String `tfsdk:"host"`
-	Token types.String `tfsdk:"token"`
+	MaxRetries     types.Int32  `tfsdk:"max_retries"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+
+	MaxConcurrentRequests        types.Int32 `tfsdk:"max_concurrent_requests"`
+	MaxConcurrentRequestsPerZone types.Int32 `tfsdk:"max_concurrent_requests_per_zone"`
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -71,15 +90,129 @@ func (p *technitiumProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 			"host": schema.StringAttribute{
 				Optional: true,
 			},
+			"endpoints": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ConflictsWith(path.MatchRoot("host")),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
 			"token": schema.StringAttribute{
 				Optional:  true,
 				Sensitive: true,
 			},
+			"username": schema.StringAttribute{
+				Optional: true,
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"totp": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token_mode": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(tokenModeAuto, tokenModeHeader, tokenModeQuery),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional: true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional: true,
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional: true,
+			},
+			"max_retries": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional: true,
+			},
+			"max_concurrent_requests": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests_per_zone": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
 
-// Configure prepares a HashiCups API client for data sources and resources.
+// hasUnknown reports whether any of the values is unknown.
+func hasUnknown(values []types.String) bool {
+	for _, value := range values {
+		if value.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// detectTokenMode sends the token in the Authorization header if the server
+// accepts it there, and falls back to the query string for older servers
+// that ignore the header and answer with invalid-token. Any other failure is
+// returned rather than taken as a reason to put the token in URLs.
+func detectTokenMode(ctx context.Context, client *technitium.APIClient, transport *CustomHTTPClient) error {
+	transport.tokenInHeader = true
+
+	// Any answer other than invalid-token means the header was accepted.
+	answ, _, err := client.UserAPI.GetSession(ctx).Execute()
+	var apiErr *APIError
+	err = checkResponse(answ, err)
+	switch {
+	case err == nil || errors.As(err, &apiErr):
+		return nil
+	case errors.Is(err, ErrInvalidToken):
+		tflog.Info(ctx, "Technitium server does not accept the token in the Authorization header, sending it in the query string")
+		transport.tokenInHeader = false
+		return nil
+	default:
+		return err
+	}
+}
+
+// Configure prepares a Technitium API client for data sources and resources.
 func (p *technitiumProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	// Retrieve provider data from configuration
 	var config technitiumProviderModel
@@ -103,6 +236,19 @@ func (p *technitiumProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	var endpointValues []types.String
+	if !config.Endpoints.IsUnknown() {
+		resp.Diagnostics.Append(config.Endpoints.ElementsAs(ctx, &endpointValues, false)...)
+	}
+	if config.Endpoints.IsUnknown() || hasUnknown(endpointValues) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoints"),
+			"Unknown Technitium API Endpoints",
+			"The provider cannot create the Technitium API client as there is an unknown configuration value for the Technitium API endpoints. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if config.Token.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
@@ -112,6 +258,102 @@ func (p *technitiumProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.Username.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Unknown Technitium API Username",
+			"The provider cannot create the Technitium API client as there is an unknown configuration value for the Technitium API username. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TECHNITIUM_USERNAME environment variable.",
+		)
+	}
+
+	if config.Password.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Unknown Technitium API Password",
+			"The provider cannot create the Technitium API client as there is an unknown configuration value for the Technitium API password. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the TECHNITIUM_PASSWORD environment variable.",
+		)
+	}
+
+	if config.Totp.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("totp"),
+			"Unknown Technitium API TOTP",
+			"The provider cannot create the Technitium API client as there is an unknown configuration value for the Technitium API one-time password. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.TokenMode.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_mode"),
+			"Unknown Technitium API Token Mode",
+			"The provider cannot create the Technitium API client as there is an unknown configuration value for the Technitium API token mode. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxRetries.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Unknown Technitium API Max Retries",
+			"The provider cannot create the Technitium API client as there is an unknown configuration value for the Technitium API max retries. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.RequestTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_timeout"),
+			"Unknown Technitium API Request Timeout",
+			"The provider cannot create the Technitium API client as there is an unknown configuration value for the Technitium API request timeout. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxConcurrentRequests.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Unknown Technitium API Max Concurrent Requests",
+			"The provider cannot create the Technitium API client as there is an unknown configuration value for the Technitium API max concurrent requests. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.MaxConcurrentRequestsPerZone.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests_per_zone"),
+			"Unknown Technitium API Max Concurrent Requests Per Zone",
+			"The provider cannot create the Technitium API client as there is an unknown configuration value for the Technitium API max concurrent requests per zone. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	tlsAttributes := []struct {
+		name    string
+		unknown bool
+	}{
+		{"ca_cert_file", config.CACertFile.IsUnknown()},
+		{"ca_cert_pem", config.CACertPEM.IsUnknown()},
+		{"client_cert_file", config.ClientCertFile.IsUnknown()},
+		{"client_cert_pem", config.ClientCertPEM.IsUnknown()},
+		{"client_key_file", config.ClientKeyFile.IsUnknown()},
+		{"client_key_pem", config.ClientKeyPEM.IsUnknown()},
+		{"tls_server_name", config.TLSServerName.IsUnknown()},
+		{"insecure_skip_verify", config.InsecureSkipVerify.IsUnknown()},
+	}
+	for _, attribute := range tlsAttributes {
+		if attribute.unknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown Technitium API TLS Setting",
+				"The provider cannot create the Technitium API client as there is an unknown configuration value for "+attribute.name+". "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -121,6 +363,10 @@ func (p *technitiumProvider) Configure(ctx context.Context, req provider.Configu
 
 	host := os.Getenv("TECHNITIUM_HOST")
 	token := os.Getenv("TECHNITIUM_TOKEN")
+	username := os.Getenv("TECHNITIUM_USERNAME")
+	password := os.Getenv("TECHNITIUM_PASSWORD")
+	totp := ""
+	tokenMode := tokenModeAuto
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
@@ -130,9 +376,65 @@ func (p *technitiumProvider) Configure(ctx context.Context, req provider.Configu
 		token = config.Token.ValueString()
 	}
 
+	if !config.Username.IsNull() {
+		username = config.Username.ValueString()
+	}
+
+	if !config.Password.IsNull() {
+		password = config.Password.ValueString()
+	}
+
+	if !config.Totp.IsNull() {
+		totp = config.Totp.ValueString()
+	}
+
+	if !config.TokenMode.IsNull() {
+		tokenMode = config.TokenMode.ValueString()
+	}
+
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt32())
+	}
+
+	maxConcurrentRequests := defaultMaxConcurrentRequests
+	if !config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt32())
+	}
+
+	maxConcurrentRequestsPerZone := defaultMaxConcurrentRequestsPerZone
+	if !config.MaxConcurrentRequestsPerZone.IsNull() {
+		maxConcurrentRequestsPerZone = int(config.MaxConcurrentRequestsPerZone.ValueInt32())
+	}
+
+	requestTimeout := defaultRequestTimeout
+	if !config.RequestTimeout.IsNull() {
+		timeout, err := time.ParseDuration(config.RequestTimeout.ValueString())
+		if err != nil || timeout < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Technitium API Request Timeout",
+				"The provider cannot create the Technitium API client as the Technitium API request timeout is not a valid duration. "+
+					"Set the request_timeout value to a duration such as \"30s\" or \"2m\", or to \"0s\" to disable the timeout.",
+			)
+		}
+		requestTimeout = timeout
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
+	// Several endpoints serving the same API may be given instead of the
+	// host; calls fail over between them.
+	endpoints := []string{host}
+	if len(endpointValues) > 0 {
+		endpoints = endpoints[:0]
+		for _, endpoint := range endpointValues {
+			endpoints = append(endpoints, endpoint.ValueString())
+		}
+		host = endpoints[0]
+	}
+
 	if host == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
@@ -143,56 +445,192 @@ func (p *technitiumProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
-	if token == "" {
+	// A token takes precedence; username and password are only needed to
+	// log in when no token is given.
+	if token == "" && username == "" && password == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
 			"Missing Technitium API Token",
 			"The provider cannot create the Technitium API client as there is a missing or empty value for the Technitium API token. "+
-				"Set the token value in the configuration or use the TECHNITIUM_TOKEN environment variable. "+
+				"Set the token value in the configuration or use the TECHNITIUM_TOKEN environment variable, "+
+				"or set username and password to log in with. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	if token == "" && username != "" && password == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Technitium API Password",
+			"The provider cannot log in to the Technitium API as there is a missing or empty value for the Technitium API password. "+
+				"Set the password value in the configuration or use the TECHNITIUM_PASSWORD environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if token == "" && username == "" && password != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Missing Technitium API Username",
+			"The provider cannot log in to the Technitium API as there is a missing or empty value for the Technitium API username. "+
+				"Set the username value in the configuration or use the TECHNITIUM_USERNAME environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	var endpointURLs []*url.URL
+	if len(endpoints) > 1 {
+		for _, endpoint := range endpoints {
+			endpointURL, err := url.Parse(endpoint)
+			if err != nil || (endpointURL.Scheme != "http" && endpointURL.Scheme != "https") || endpointURL.Host == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("endpoints"),
+					"Invalid Technitium API Endpoint",
+					"The provider cannot create the Technitium API client as the endpoint "+endpoint+" is not a valid URL. "+
+						"Set each endpoint to the URL of a Technitium web service, such as https://dns1.example.com:53443.",
+				)
+				continue
+			}
+			endpointURLs = append(endpointURLs, endpointURL)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Create a new HashiCups client using the configuration values
+	tlsConfig, err := newTLSConfig(tlsSettings{
+		CACertFile:         config.CACertFile.ValueString(),
+		CACertPEM:          config.CACertPEM.ValueString(),
+		ClientCertFile:     config.ClientCertFile.ValueString(),
+		ClientCertPEM:      config.ClientCertPEM.ValueString(),
+		ClientKeyFile:      config.ClientKeyFile.ValueString(),
+		ClientKeyPEM:       config.ClientKeyPEM.ValueString(),
+		ServerName:         config.TLSServerName.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Technitium API TLS Configuration",
+			"The provider cannot create the Technitium API client as the TLS configuration is invalid.\n\n"+
+				"TLS Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Create a new Technitium client using the configuration values
+	var transport http.RoundTripper = newTransport(tlsConfig)
+	if len(endpointURLs) > 1 {
+		transport = &failoverTransport{
+			next:      transport,
+			endpoints: endpointURLs,
+		}
+	}
 	customClient := &CustomHTTPClient{
-		client: http.DefaultClient,
-		token:  token,
+		client: &http.Client{
+			Transport: transport,
+		},
+		token:         token,
+		tokenInHeader: tokenMode == tokenModeHeader,
 	}
-	cfg := technitium.NewConfiguration()
-	cfg.Servers = technitium.ServerConfigurations{
+	logging := &loggingTransport{
+		next: customClient,
+		secrets: func() []string {
+			return []string{customClient.currentToken(), password, totp}
+		},
+	}
+	servers := technitium.ServerConfigurations{
 		{
 			URL: host,
 		},
 	}
+	cfg := technitium.NewConfiguration()
+	cfg.Servers = servers
 	cfg.HTTPClient = &http.Client{
-		Transport: customClient,
+		Transport: &retryTransport{
+			next: newLimitTransport(
+				&timeoutTransport{
+					next:    logging,
+					timeout: requestTimeout,
+				},
+				maxConcurrentRequests,
+				maxConcurrentRequestsPerZone,
+			),
+			maxRetries: maxRetries,
+		},
 	}
 	apiClient := technitium.NewAPIClient(cfg)
 
-	// if err != nil {
-	// 	resp.Diagnostics.AddError(
-	// 		"Unable to Create HashiCups API Client",
-	// 		"An unexpected error occurred when creating the HashiCups API client. "+
-	// 			"If the error is not clear, please contact the provider developers.\n\n"+
-	// 			"HashiCups Client Error: "+err.Error(),
-	// 	)
-	// 	return
-	// }
-
-	// Make the HashiCups client available during DataSource and Resource
+	// Without a token, log in with the credentials and use the session
+	// token for all further calls. The session is renewed when it expires
+	// and closed on shutdown.
+	if token == "" {
+		// Sessions are created through a client that bypasses the retry
+		// and concurrency limits, as a session may have to be renewed
+		// while a call holds a concurrency slot.
+		loginCfg := technitium.NewConfiguration()
+		loginCfg.Servers = servers
+		loginCfg.HTTPClient = &http.Client{
+			Transport: logging,
+		}
+		loginClient := technitium.NewAPIClient(loginCfg)
+		customClient.login = func(ctx context.Context) (string, error) {
+			return login(ctx, loginClient, username, password, totp)
+		}
+
+		sessionToken, err := customClient.login(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Log In to Technitium API",
+				"The provider could not log in to the Technitium API with the configured username and password.\n\n"+
+					"Technitium Client Error: "+err.Error(),
+			)
+			return
+		}
+		customClient.setToken(sessionToken)
+		sessions.add(apiClient)
+	}
+
+	if tokenMode == tokenModeAuto {
+		if err := detectTokenMode(ctx, apiClient, customClient); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Connect to Technitium API",
+				"The provider could not reach the Technitium API to detect whether it accepts the token in the Authorization header. "+
+					"Check the host and TLS settings, or set token_mode to \"header\" or \"query\" to skip the detection.\n\n"+
+					"Technitium Client Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	client := &technitiumClient{
+		APIClient: apiClient,
+		version:   detectServerVersion(ctx, apiClient),
+	}
+
+	tflog.Debug(ctx, "Created Technitium client", map[string]interface{}{
+		"endpoints":               strings.Join(endpoints, ","),
+		"server_version":          client.version.String(),
+		"token_in_header":         customClient.tokenInHeader,
+		"max_retries":             maxRetries,
+		"request_timeout":         requestTimeout.String(),
+		"max_concurrent":          maxConcurrentRequests,
+		"max_concurrent_per_zone": maxConcurrentRequestsPerZone,
+	})
+
+	// Make the Technitium client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = apiClient
-	resp.ResourceData = apiClient
+	resp.DataSourceData = client
+	resp.ResourceData = client
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *technitiumProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewDnsZonesDataSource,
+		NewServerInfoDataSource,
+		NewClusterNodesDataSource,
+		NewDhcpLeasesDataSource,
 	}
 }
 
@@ -201,5 +639,22 @@ func (p *technitiumProvider) Resources(_ context.Context) []func() resource.Reso
 	return []func() resource.Resource{
 		NewDnsZoneResource,
 		NewDnsRecordResource,
+		NewClusterResource,
+		NewClusterNodeResource,
+		NewDhcpScopeResource,
+		NewDhcpReservedLeaseResource,
+		NewDnsSettingsResource,
+		NewForwardersResource,
+		NewRecursionResource,
+		NewCacheSettingsResource,
+		NewCacheFlushResource,
+		NewBlockListsResource,
+		NewBlockingResource,
+		NewAllowedZoneResource,
+		NewBlockedZoneResource,
+		NewAllowedZonesResource,
+		NewBlockedZonesResource,
+		NewDnsAppResource,
+		NewDnsAppConfigResource,
 	}
 }
@@ -4,8 +4,16 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -15,7 +23,7 @@ import (
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"scaffolding": providerserver.NewProtocol6WithError(New("test")()),
+	"technitium": providerserver.NewProtocol6WithError(New("test")()),
 }
 
 func testAccPreCheck(t *testing.T) {
@@ -23,3 +31,90 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testAccProviderConfig returns the provider configuration for the fake
+// Technitium server.
+func testAccProviderConfig(server *fakeServer) string {
+	return fmt.Sprintf(`
+provider "technitium" {
+  host  = %q
+  token = %q
+}
+`, server.URL, fakeAPIToken)
+}
+
+func TestAccProvider_endpoints(t *testing.T) {
+	server := newFakeServer(t)
+	config := fmt.Sprintf(`
+provider "technitium" {
+  endpoints = [%q, %q]
+  token     = %q
+}
+`, unreachableURL(), server.URL, fakeAPIToken)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDnsZoneDestroy(server, "example.com"),
+		Steps: []resource.TestStep{
+			{
+				Config: config + testAccDnsZoneResourceConfig("example.com", "Primary"),
+				Check:  testAccCheckDnsZoneExists(server, "example.com", "Primary"),
+			},
+		},
+	})
+}
+
+func TestDetectTokenMode(t *testing.T) {
+	// An older server that only reads the token from the query string.
+	legacy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("token") != fakeAPIToken {
+			writeFakeAnswer(w, fakeTopLevel{"status": statusInvalidToken})
+			return
+		}
+		writeFakeAnswer(w, fakeTopLevel{"status": statusOk})
+	}))
+	t.Cleanup(legacy.Close)
+
+	tests := map[string]struct {
+		url       string
+		inHeader  bool
+		expectErr bool
+	}{
+		"header accepted":    {url: newFakeServer(t).URL, inHeader: true},
+		"header ignored":     {url: legacy.URL, inHeader: false},
+		"server unreachable": {url: unreachableURL(), expectErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			customClient := &CustomHTTPClient{
+				client: &http.Client{
+					Transport: http.DefaultTransport,
+				},
+				token: fakeAPIToken,
+			}
+			cfg := technitium.NewConfiguration()
+			cfg.Servers = technitium.ServerConfigurations{{URL: test.url}}
+			cfg.HTTPClient = &http.Client{
+				Transport: customClient,
+			}
+
+			err := detectTokenMode(context.Background(), technitium.NewAPIClient(cfg), customClient)
+			if test.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if !customClient.tokenInHeader {
+					t.Error("token moved to the query string after a transport error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if customClient.tokenInHeader != test.inHeader {
+				t.Errorf("expected token in header %t, got %t", test.inHeader, customClient.tokenInHeader)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &recursionResource{}
+	_ resource.ResourceWithConfigure = &recursionResource{}
+)
+
+// NewRecursionResource is a helper function to simplify the provider implementation.
+func NewRecursionResource() resource.Resource {
+	return &recursionResource{
+		settingsResource: settingsResource[recursionResourceModel]{
+			id:      "recursion",
+			name:    "recursion settings",
+			set:     setRecursion,
+			refresh: refreshRecursion,
+		},
+	}
+}
+
+// recursionResource manages which clients may use the DNS server as a
+// recursive resolver, and how it resolves.
+type recursionResource struct {
+	settingsResource[recursionResourceModel]
+}
+
+// recursionResourceModel maps the resource schema data.
+type recursionResourceModel struct {
+	ID                    types.String `tfsdk:"id"`
+	Recursion             types.String `tfsdk:"recursion"`
+	RecursionNetworkACL   types.List   `tfsdk:"recursion_network_acl"`
+	RandomizeName         types.Bool   `tfsdk:"randomize_name"`
+	QnameMinimization     types.Bool   `tfsdk:"qname_minimization"`
+	NsRevalidation        types.Bool   `tfsdk:"ns_revalidation"`
+	ResolverRetries       types.Int32  `tfsdk:"resolver_retries"`
+	ResolverTimeout       types.Int32  `tfsdk:"resolver_timeout"`
+	ResolverConcurrency   types.Int32  `tfsdk:"resolver_concurrency"`
+	ResolverMaxStackCount types.Int32  `tfsdk:"resolver_max_stack_count"`
+}
+
+// Metadata returns the resource type name.
+func (r *recursionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recursion"
+}
+
+// Schema defines the schema for the resource.
+func (r *recursionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	recursion := settingString(
+		stringvalidator.OneOf("Allow", "Deny", "AllowOnlyForPrivateNetworks", "UseSpecifiedNetworkACL"),
+	)
+	recursion.Optional = false
+	recursion.Required = true
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"recursion":                recursion,
+			"recursion_network_acl":    settingList(networkACLEntry()),
+			"randomize_name":           settingBool(),
+			"qname_minimization":       settingBool(),
+			"ns_revalidation":          settingBool(),
+			"resolver_retries":         settingInt32(int32validator.Between(1, 10)),
+			"resolver_timeout":         settingInt32(int32validator.Between(1000, 10000)),
+			"resolver_concurrency":     settingInt32(int32validator.Between(1, 4)),
+			"resolver_max_stack_count": settingInt32(int32validator.Between(10, 30)),
+		},
+	}
+}
+
+// setRecursion adds the declared recursion settings to a set settings request.
+func setRecursion(ctx context.Context, settings technitium.ApiSetSettingsRequest, plan recursionResourceModel, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+	settings = settings.Recursion(plan.Recursion.ValueString())
+	if !plan.RecursionNetworkACL.IsNull() {
+		settings = settings.RecursionNetworkACL(stringListElements(ctx, plan.RecursionNetworkACL, diags))
+	}
+	if !plan.RandomizeName.IsNull() {
+		settings = settings.RandomizeName(plan.RandomizeName.ValueBool())
+	}
+	if !plan.QnameMinimization.IsNull() {
+		settings = settings.QnameMinimization(plan.QnameMinimization.ValueBool())
+	}
+	if !plan.NsRevalidation.IsNull() {
+		settings = settings.NsRevalidation(plan.NsRevalidation.ValueBool())
+	}
+	if !plan.ResolverRetries.IsNull() {
+		settings = settings.ResolverRetries(plan.ResolverRetries.ValueInt32())
+	}
+	if !plan.ResolverTimeout.IsNull() {
+		settings = settings.ResolverTimeout(plan.ResolverTimeout.ValueInt32())
+	}
+	if !plan.ResolverConcurrency.IsNull() {
+		settings = settings.ResolverConcurrency(plan.ResolverConcurrency.ValueInt32())
+	}
+	if !plan.ResolverMaxStackCount.IsNull() {
+		settings = settings.ResolverMaxStackCount(plan.ResolverMaxStackCount.ValueInt32())
+	}
+	return settings
+}
+
+// refreshRecursion copies the managed recursion settings from the server.
+func refreshRecursion(ctx context.Context, model *recursionResourceModel, settings *technitium.DnsSettings, diags *diag.Diagnostics) {
+	model.Recursion = refreshString(model.Recursion, settings.Recursion)
+	model.RecursionNetworkACL = refreshList(ctx, model.RecursionNetworkACL, settings.RecursionNetworkACL, diags)
+	model.RandomizeName = refreshBool(model.RandomizeName, settings.RandomizeName)
+	model.QnameMinimization = refreshBool(model.QnameMinimization, settings.QnameMinimization)
+	model.NsRevalidation = refreshBool(model.NsRevalidation, settings.NsRevalidation)
+	model.ResolverRetries = refreshInt32(model.ResolverRetries, settings.ResolverRetries)
+	model.ResolverTimeout = refreshInt32(model.ResolverTimeout, settings.ResolverTimeout)
+	model.ResolverConcurrency = refreshInt32(model.ResolverConcurrency, settings.ResolverConcurrency)
+	model.ResolverMaxStackCount = refreshInt32(model.ResolverMaxStackCount, settings.ResolverMaxStackCount)
+}
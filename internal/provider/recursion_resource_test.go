@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRecursionResource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccRecursionResourceConfig(`
+  recursion             = "UseSpecifiedNetworkACL"
+  recursion_network_acl = ["!10.0.0.13", "10.0.0.0/8", "fd00::/8"]
+  qname_minimization    = false
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_recursion.test", "id", "recursion"),
+					resource.TestCheckResourceAttr("technitium_recursion.test", "recursion", "UseSpecifiedNetworkACL"),
+					resource.TestCheckResourceAttr("technitium_recursion.test", "recursion_network_acl.#", "3"),
+					resource.TestCheckResourceAttr("technitium_recursion.test", "recursion_network_acl.0", "!10.0.0.13"),
+					resource.TestCheckResourceAttr("technitium_recursion.test", "qname_minimization", "false"),
+					resource.TestCheckNoResourceAttr("technitium_recursion.test", "randomize_name"),
+					testAccCheckDnsSetting(server, "recursion", "UseSpecifiedNetworkACL"),
+					testAccCheckDnsSetting(server, "recursionNetworkACL", []string{"!10.0.0.13", "10.0.0.0/8", "fd00::/8"}),
+					testAccCheckDnsSetting(server, "qnameMinimization", false),
+					testAccCheckDnsSetting(server, "randomizeName", true),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig(server) + testAccRecursionResourceConfig(`
+  recursion             = "AllowOnlyForPrivateNetworks"
+  recursion_network_acl = []
+  randomize_name        = false
+  resolver_retries      = 3
+  resolver_timeout      = 2000
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_recursion.test", "recursion", "AllowOnlyForPrivateNetworks"),
+					resource.TestCheckResourceAttr("technitium_recursion.test", "recursion_network_acl.#", "0"),
+					resource.TestCheckResourceAttr("technitium_recursion.test", "randomize_name", "false"),
+					resource.TestCheckNoResourceAttr("technitium_recursion.test", "qname_minimization"),
+					testAccCheckDnsSetting(server, "recursion", "AllowOnlyForPrivateNetworks"),
+					testAccCheckDnsSetting(server, "recursionNetworkACL", []string{}),
+					testAccCheckDnsSetting(server, "resolverRetries", 3),
+					testAccCheckDnsSetting(server, "resolverTimeout", 2000),
+				),
+			},
+			// A recursion mode changed outside of Terraform is detected and reverted
+			testAccSettingsDriftStep(server, testAccProviderConfig(server)+testAccRecursionResourceConfig(`
+  recursion             = "AllowOnlyForPrivateNetworks"
+  recursion_network_acl = []
+  randomize_name        = false
+  resolver_retries      = 3
+  resolver_timeout      = 2000
+`), []settingDrift{
+				{key: "recursion", drifted: "Allow", want: "AllowOnlyForPrivateNetworks"},
+			}),
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecursionResource_invalidNetworkACL(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccRecursionResourceConfig(`
+  recursion             = "UseSpecifiedNetworkACL"
+  recursion_network_acl = ["10.0.0.0/33"]
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid\s+Network\s+ACL\s+Entry`),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccRecursionResourceConfig(`
+  recursion = "AllowAll"
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid\s+Attribute\s+Value\s+Match`),
+			},
+		},
+	})
+}
+
+func testAccRecursionResourceConfig(settings string) string {
+	return fmt.Sprintf(`
+resource "technitium_recursion" "test" {%s}
+`, settings)
+}
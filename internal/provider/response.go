@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Technitium API response statuses.
+const (
+	statusOk           = "ok"
+	statusInvalidToken = "invalid-token"
+)
+
+// ErrInvalidToken is returned when the server rejects the API token, or the
+// session expired and could not be renewed.
+var ErrInvalidToken = errors.New("invalid token or session expired")
+
+// APIError is returned when the server answers a call with a status other
+// than ok, usually "error" with a message explaining why.
+type APIError struct {
+	Status  string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return "technitium api returned status " + e.Status
+	}
+	return e.Message
+}
+
+// apiResponse is implemented by every Technitium API response model.
+type apiResponse interface {
+	GetStatus() string
+	GetErrorMessage() string
+}
+
+// checkResponse turns the result of an API call into a single error: the
+// transport error if the call failed, ErrInvalidToken or an *APIError if the
+// server did not answer with status ok, and nil otherwise.
+func checkResponse(answ apiResponse, err error) error {
+	if err != nil {
+		return err
+	}
+
+	switch answ.GetStatus() {
+	case statusOk:
+		return nil
+	case statusInvalidToken:
+		return ErrInvalidToken
+	default:
+		return &APIError{
+			Status:  answ.GetStatus(),
+			Message: answ.GetErrorMessage(),
+		}
+	}
+}
+
+// addAPIError reports a failed API call. operation is the verb of what was
+// attempted ("create", "read", ...), kind the type of object and name the
+// object itself, giving for example "Error creating dns zone" with the
+// detail "Could not create dns zone example.com, ...".
+func addAPIError(diags *diag.Diagnostics, operation string, kind string, name string, err error) {
+	subject := kind
+	if name != "" {
+		subject += " " + name
+	}
+
+	diags.AddError(
+		"Error "+gerund(operation)+" "+kind,
+		"Could not "+operation+" "+subject+", "+describeAPIError(err),
+	)
+}
+
+// describeAPIError explains err for a diagnostic detail.
+func describeAPIError(err error) string {
+	var apiErr *APIError
+
+	switch {
+	case errors.Is(err, ErrInvalidToken):
+		return "the Technitium API rejected the token or the session expired. " +
+			"Check that the configured token is valid, or configure username and password so the provider can log in again."
+	case errors.As(err, &apiErr):
+		return "Technitium returned an error: " + apiErr.Error()
+	default:
+		return "unexpected error: " + err.Error()
+	}
+}
+
+func gerund(verb string) string {
+	return strings.TrimSuffix(verb, "e") + "ing"
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &serverInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &serverInfoDataSource{}
+)
+
+// serverInfoDataSourceModel maps the data source schema data.
+type serverInfoDataSourceModel struct {
+	Version          types.String `tfsdk:"version"`
+	UpSince          types.String `tfsdk:"up_since"`
+	UptimeSeconds    types.Int64  `tfsdk:"uptime_seconds"`
+	DnsServerDomain  types.String `tfsdk:"dns_server_domain"`
+	LocalEndpoints   types.List   `tfsdk:"local_endpoints"`
+	DnssecValidation types.Bool   `tfsdk:"dnssec_validation"`
+	Recursion        types.String `tfsdk:"recursion"`
+	RecursionEnabled types.Bool   `tfsdk:"recursion_enabled"`
+	BlockingEnabled  types.Bool   `tfsdk:"blocking_enabled"`
+}
+
+type serverInfoDataSource struct {
+	client *technitiumClient
+}
+
+func NewServerInfoDataSource() datasource.DataSource {
+	return &serverInfoDataSource{}
+}
+
+func (d *serverInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *serverInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Computed: true,
+			},
+			"up_since": schema.StringAttribute{
+				Computed: true,
+			},
+			"uptime_seconds": schema.Int64Attribute{
+				Computed: true,
+			},
+			"dns_server_domain": schema.StringAttribute{
+				Computed: true,
+			},
+			"local_endpoints": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"dnssec_validation": schema.BoolAttribute{
+				Computed: true,
+			},
+			"recursion": schema.StringAttribute{
+				Computed: true,
+			},
+			"recursion_enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+			"blocking_enabled": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *serverInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	answ, _, err := d.client.SettingsAPI.GetSettings(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", "server info", "", err)
+		return
+	}
+	settings := answ.Response
+
+	// Map response body to model
+	endpoints, diags := types.ListValueFrom(ctx, types.StringType, settings.DnsServerLocalEndPoints)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := serverInfoDataSourceModel{
+		Version:          types.StringPointerValue(settings.Version),
+		UpSince:          types.StringPointerValue(settings.Uptimestamp),
+		DnsServerDomain:  types.StringPointerValue(settings.DnsServerDomain),
+		LocalEndpoints:   endpoints,
+		DnssecValidation: types.BoolPointerValue(settings.DnssecValidation),
+		Recursion:        types.StringPointerValue(settings.Recursion),
+		RecursionEnabled: types.BoolValue(settings.GetRecursion() != "Deny"),
+		BlockingEnabled:  types.BoolPointerValue(settings.EnableBlocking),
+	}
+
+	// Fall back to the version detected at Configure for servers that do not
+	// report it with the settings.
+	if state.Version.IsNull() && d.client.version.known() {
+		state.Version = types.StringValue(d.client.version.String())
+	}
+	// The uptime is counted from up_since at the time of the read.
+	state.UptimeSeconds = types.Int64Null()
+	if upSince, err := time.Parse(time.RFC3339, settings.GetUptimestamp()); err == nil {
+		state.UptimeSeconds = types.Int64Value(int64(time.Since(upSince).Seconds()))
+	}
+	if settings.Recursion == nil {
+		state.RecursionEnabled = types.BoolNull()
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *serverInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerInfoDataSource(t *testing.T) {
+	server := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `data "technitium_server_info" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.technitium_server_info.test", "version", fakeVersion),
+					resource.TestCheckResourceAttr("data.technitium_server_info.test", "up_since", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrWith("data.technitium_server_info.test", "uptime_seconds", func(value string) error {
+						seconds, err := strconv.ParseInt(value, 10, 64)
+						if err != nil {
+							return err
+						}
+						if want := int64(time.Since(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).Seconds()); seconds > want || seconds < want-60 {
+							return fmt.Errorf("uptime_seconds is %d, expected about %d", seconds, want)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttr("data.technitium_server_info.test", "dns_server_domain", "dns.fake"),
+					resource.TestCheckResourceAttr("data.technitium_server_info.test", "local_endpoints.#", "2"),
+					resource.TestCheckResourceAttr("data.technitium_server_info.test", "local_endpoints.0", "0.0.0.0:53"),
+					resource.TestCheckResourceAttr("data.technitium_server_info.test", "dnssec_validation", "true"),
+					resource.TestCheckResourceAttr("data.technitium_server_info.test", "recursion", "AllowOnlyForPrivateNetworks"),
+					resource.TestCheckResourceAttr("data.technitium_server_info.test", "recursion_enabled", "true"),
+					resource.TestCheckResourceAttr("data.technitium_server_info.test", "blocking_enabled", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+)
+
+// sessions holds the clients that logged in with a username and password so
+// their sessions can be closed when the provider shuts down.
+var sessions = &sessionRegistry{}
+
+type sessionRegistry struct {
+	mu      sync.Mutex
+	clients []*technitium.APIClient
+}
+
+func (s *sessionRegistry) add(client *technitium.APIClient) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.clients = append(s.clients, client)
+}
+
+// CloseSessions logs out of every session opened by the provider. It is
+// best-effort: sessions that cannot be closed simply expire on the server.
+func CloseSessions(ctx context.Context) {
+	sessions.mu.Lock()
+	clients := sessions.clients
+	sessions.clients = nil
+	sessions.mu.Unlock()
+
+	for _, client := range clients {
+		_, _, _ = client.UserAPI.Logout(ctx).Execute()
+	}
+}
+
+// login creates a session for the user and returns its token.
+func login(ctx context.Context, client *technitium.APIClient, username, password, totp string) (string, error) {
+	request := client.UserAPI.Login(ctx)
+	request = request.User(username)
+	request = request.Pass(password)
+	if totp != "" {
+		request = request.Totp(totp)
+	}
+
+	answ, _, err := request.Execute()
+	if err = checkResponse(answ, err); err != nil {
+		return "", err
+	}
+
+	if answ.GetToken() == "" {
+		return "", errors.New("login response did not contain a session token")
+	}
+
+	return answ.GetToken(), nil
+}
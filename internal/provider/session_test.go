@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+)
+
+// newFakeClient returns a client for the fake server that logs in with the
+// fake credentials and renews its session like the configured provider.
+func newFakeClient(t *testing.T, server *fakeServer) (*technitium.APIClient, *CustomHTTPClient) {
+	t.Helper()
+
+	customClient := &CustomHTTPClient{
+		client: &http.Client{
+			Transport: http.DefaultTransport,
+		},
+		tokenInHeader: true,
+	}
+	cfg := technitium.NewConfiguration()
+	cfg.Servers = technitium.ServerConfigurations{{URL: server.URL}}
+	cfg.HTTPClient = &http.Client{
+		Transport: customClient,
+	}
+	client := technitium.NewAPIClient(cfg)
+
+	customClient.login = func(ctx context.Context) (string, error) {
+		return login(ctx, client, fakeUsername, fakePassword, "")
+	}
+	token, err := customClient.login(context.Background())
+	if err != nil {
+		t.Fatalf("logging in: %s", err)
+	}
+	customClient.setToken(token)
+
+	return client, customClient
+}
+
+func TestLogin(t *testing.T) {
+	server := newFakeServer(t)
+	client := technitium.NewAPIClient(&technitium.Configuration{
+		Servers: technitium.ServerConfigurations{{URL: server.URL}},
+	})
+
+	token, err := login(context.Background(), client, fakeUsername, fakePassword, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !server.hasSession(token) {
+		t.Errorf("token %q is not a session of the server", token)
+	}
+
+	_, err = login(context.Background(), client, fakeUsername, "wrong", "")
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.Message != "Invalid username or password for user: admin" {
+		t.Errorf("expected invalid password error, got: %v", err)
+	}
+}
+
+func TestSessionRenewal(t *testing.T) {
+	server := newFakeServer(t)
+	client, customClient := newFakeClient(t, server)
+	expired := customClient.currentToken()
+
+	server.expireSessions()
+
+	answ, _, err := client.DnsZoneAPI.ListDnsZones(context.Background()).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		t.Fatalf("expected the call to succeed after renewing the session, got: %s", err)
+	}
+	if customClient.currentToken() == expired {
+		t.Error("session token was not renewed")
+	}
+}
+
+func TestSessionRenewalFailure(t *testing.T) {
+	server := newFakeServer(t)
+	client, _ := newFakeClient(t, server)
+
+	server.expireSessions()
+	server.mu.Lock()
+	server.users[fakeUsername] = "changed"
+	server.mu.Unlock()
+
+	answ, _, err := client.DnsZoneAPI.ListDnsZones(context.Background()).Execute()
+	if err = checkResponse(answ, err); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken, got: %v", err)
+	}
+}
+
+func TestCloseSessions(t *testing.T) {
+	server := newFakeServer(t)
+	client, customClient := newFakeClient(t, server)
+	sessions.add(client)
+
+	CloseSessions(context.Background())
+
+	if server.hasSession(customClient.currentToken()) {
+		t.Error("session was not logged out")
+	}
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// settingsResource implements the operations shared by the settings
+// resources, which each manage a part of the server wide settings. Only the
+// attributes present in the configuration are sent to the server and
+// refreshed from it, so settings that are not declared keep the value set in
+// the web console, and several resources can share the settings without
+// fighting over them. Destroying a settings resource leaves the settings as
+// they are.
+type settingsResource[M any] struct {
+	client *technitiumClient
+
+	// id is the ID of the resource, there is only one of each.
+	id string
+	// name names the settings in error messages.
+	name string
+	// set adds the declared settings of a model to the request.
+	set func(ctx context.Context, settings technitium.ApiSetSettingsRequest, model M, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest
+	// refresh copies the declared settings of a model from the server.
+	refresh func(ctx context.Context, model *M, settings *technitium.DnsSettings, diags *diag.Diagnostics)
+	// applied, when set, runs once the settings are sent. The state is nil
+	// on create.
+	applied func(ctx context.Context, plan M, state *M, diags *diag.Diagnostics)
+}
+
+// Create applies the declared settings and sets the initial Terraform state.
+func (r *settingsResource[M]) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply the declared settings
+	settings := r.setSettings(ctx, plan, &resp.Diagnostics, "create")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.refresh(ctx, &plan, settings, &resp.Diagnostics)
+	if r.applied != nil {
+		r.applied(ctx, plan, nil, &resp.Diagnostics)
+	}
+
+	// Set state to fully populated data
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), r.id)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *settingsResource[M]) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state M
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed settings from Technitium
+	answ, _, err := r.client.SettingsAPI.GetSettings(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(&resp.Diagnostics, "read", r.name, "", err)
+		return
+	}
+	r.refresh(ctx, &state, answ.Response, &resp.Diagnostics)
+
+	// Set refreshed state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the declared settings and sets the updated Terraform state
+// on success.
+func (r *settingsResource[M]) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state M
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := r.setSettings(ctx, plan, &resp.Diagnostics, "update")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.refresh(ctx, &plan, settings, &resp.Diagnostics)
+	if r.applied != nil {
+		r.applied(ctx, plan, &state, &resp.Diagnostics)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the Terraform state, the settings stay
+// as they are on the server.
+func (r *settingsResource[M]) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *settingsResource[M]) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*technitiumClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *technitiumClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// setSettings sends the declared settings and returns the settings the
+// server answers with.
+func (r *settingsResource[M]) setSettings(ctx context.Context, plan M, diags *diag.Diagnostics, operation string) *technitium.DnsSettings {
+	settings := r.set(ctx, r.client.SettingsAPI.SetSettings(ctx), plan, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	answ, _, err := settings.Execute()
+	if err = checkResponse(answ, err); err != nil {
+		addAPIError(diags, operation, r.name, "", err)
+		return nil
+	}
+	return answ.Response
+}
+
+// settingString is the schema of an optional string setting.
+func settingString(validators ...validator.String) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:   true,
+		Validators: append([]validator.String{stringvalidator.LengthAtLeast(1)}, validators...),
+	}
+}
+
+// settingInt32 is the schema of an optional number setting.
+func settingInt32(validators ...validator.Int32) schema.Int32Attribute {
+	return schema.Int32Attribute{
+		Optional:   true,
+		Validators: validators,
+	}
+}
+
+// settingInt64 is the schema of an optional large number setting.
+func settingInt64(validators ...validator.Int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:   true,
+		Validators: validators,
+	}
+}
+
+// settingBool is the schema of an optional boolean setting.
+func settingBool() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+	}
+}
+
+// settingList is the schema of an optional list setting, the validators
+// apply to each element.
+func settingList(validators ...validator.String) schema.ListAttribute {
+	return schema.ListAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(append([]validator.String{stringvalidator.LengthAtLeast(1)}, validators...)...),
+		},
+	}
+}
+
+// refreshString returns the server value of a managed setting, leaving
+// settings that are not managed null. A setting the server leaves out keeps
+// its current value.
+func refreshString(current types.String, value *string) types.String {
+	if current.IsNull() || value == nil {
+		return current
+	}
+	return types.StringPointerValue(value)
+}
+
+// refreshInt32 returns the server value of a managed setting, leaving
+// settings that are not managed null. A setting the server leaves out keeps
+// its current value.
+func refreshInt32(current types.Int32, value *int32) types.Int32 {
+	if current.IsNull() || value == nil {
+		return current
+	}
+	return types.Int32PointerValue(value)
+}
+
+// refreshInt64 returns the server value of a managed setting, leaving
+// settings that are not managed null. A setting the server leaves out keeps
+// its current value.
+func refreshInt64(current types.Int64, value *int64) types.Int64 {
+	if current.IsNull() || value == nil {
+		return current
+	}
+	return types.Int64PointerValue(value)
+}
+
+// refreshBool returns the server value of a managed setting, leaving
+// settings that are not managed null. A setting the server leaves out keeps
+// its current value.
+func refreshBool(current types.Bool, value *bool) types.Bool {
+	if current.IsNull() || value == nil {
+		return current
+	}
+	return types.BoolPointerValue(value)
+}
+
+// refreshList returns the server value of a managed list setting, leaving
+// settings that are not managed null. The server leaves out empty lists, so
+// they are reported as empty rather than null.
+func refreshList(ctx context.Context, current types.List, values []string, diags *diag.Diagnostics) types.List {
+	if current.IsNull() {
+		return current
+	}
+	if values == nil {
+		values = []string{}
+	}
+	list, d := types.ListValueFrom(ctx, types.StringType, values)
+	diags.Append(d...)
+	return list
+}
@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"testing"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRefreshSettings(t *testing.T) {
+	serverString, serverInt32, serverInt64, serverBool := "Https", int32(5), int64(5000000000), true
+
+	tests := []struct {
+		name      string
+		got, want interface{}
+	}{
+		// Settings that are not managed stay null
+		{name: "string not managed", got: refreshString(types.StringNull(), &serverString), want: types.StringNull()},
+		{name: "int32 not managed", got: refreshInt32(types.Int32Null(), &serverInt32), want: types.Int32Null()},
+		{name: "int64 not managed", got: refreshInt64(types.Int64Null(), &serverInt64), want: types.Int64Null()},
+		{name: "bool not managed", got: refreshBool(types.BoolNull(), &serverBool), want: types.BoolNull()},
+		// Managed settings take the server value
+		{name: "string", got: refreshString(types.StringValue("Udp"), &serverString), want: types.StringValue("Https")},
+		{name: "int32", got: refreshInt32(types.Int32Value(1), &serverInt32), want: types.Int32Value(5)},
+		{name: "int64", got: refreshInt64(types.Int64Value(1), &serverInt64), want: types.Int64Value(5000000000)},
+		{name: "bool", got: refreshBool(types.BoolValue(false), &serverBool), want: types.BoolValue(true)},
+		// Settings the server leaves out keep their value
+		{name: "string left out", got: refreshString(types.StringValue("Udp"), nil), want: types.StringValue("Udp")},
+		{name: "int32 left out", got: refreshInt32(types.Int32Value(1), nil), want: types.Int32Value(1)},
+		{name: "int64 left out", got: refreshInt64(types.Int64Value(1), nil), want: types.Int64Value(1)},
+		{name: "bool left out", got: refreshBool(types.BoolValue(false), nil), want: types.BoolValue(false)},
+	}
+
+	for _, test := range tests {
+		if !reflect.DeepEqual(test.got, test.want) {
+			t.Errorf("%s: got %s, expected %s", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestRefreshList(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	if got := refreshList(ctx, types.ListNull(types.StringType), []string{"1.1.1.1"}, &diags); !got.IsNull() {
+		t.Errorf("list not managed: got %s, expected null", got)
+	}
+	// The server leaves out empty lists
+	if got := refreshList(ctx, testStringList(t, "1.1.1.1"), nil, &diags); !got.Equal(testStringList(t)) {
+		t.Errorf("list left out: got %s, expected an empty list", got)
+	}
+	if got := refreshList(ctx, testStringList(t), []string{"1.1.1.1"}, &diags); !got.Equal(testStringList(t, "1.1.1.1")) {
+		t.Errorf("list: got %s, expected [1.1.1.1]", got)
+	}
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestRefreshForwarders(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	protocol, retries := "Tls", int32(3)
+
+	model := forwardersResourceModel{
+		Forwarders:        testStringList(t, "1.1.1.1"),
+		ForwarderProtocol: types.StringValue("Udp"),
+	}
+	refreshForwarders(ctx, &model, &technitium.DnsSettings{
+		Forwarders:        []string{"9.9.9.9"},
+		ForwarderProtocol: &protocol,
+		ForwarderRetries:  &retries,
+	}, &diags)
+
+	if !model.Forwarders.Equal(testStringList(t, "9.9.9.9")) {
+		t.Errorf("forwarders: got %s, expected [9.9.9.9]", model.Forwarders)
+	}
+	if model.ForwarderProtocol.ValueString() != "Tls" {
+		t.Errorf("forwarder_protocol: got %s, expected Tls", model.ForwarderProtocol)
+	}
+	if !model.ForwarderRetries.IsNull() {
+		t.Errorf("forwarder_retries: got %s, expected null as it is not declared", model.ForwarderRetries)
+	}
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestRefreshBlockLists(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	model := blockListsResourceModel{
+		BlockListURLs: testStringList(t),
+		AllowListURLs: testStringList(t),
+	}
+	refreshBlockLists(ctx, &model, &technitium.DnsSettings{
+		BlockListUrls: []string{"https://example.com/hosts.txt", "!https://example.com/allow.txt"},
+	}, &diags)
+
+	if !model.BlockListURLs.Equal(testStringList(t, "https://example.com/hosts.txt")) {
+		t.Errorf("block_list_urls: got %s", model.BlockListURLs)
+	}
+	if !model.AllowListURLs.Equal(testStringList(t, "https://example.com/allow.txt")) {
+		t.Errorf("allow_list_urls: got %s", model.AllowListURLs)
+	}
+	if !model.UpdateIntervalHours.IsNull() {
+		t.Errorf("update_interval_hours: got %s, expected null as it is not declared", model.UpdateIntervalHours)
+	}
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestSetSettingsSendsDeclaredOnly(t *testing.T) {
+	tests := []struct {
+		name string
+		set  setSettingsFunc
+		want []string
+	}{
+		{
+			name: "forwarders",
+			set: func(ctx context.Context, settings technitium.ApiSetSettingsRequest, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+				return setForwarders(ctx, settings, forwardersResourceModel{
+					Forwarders:        testStringList(t, "1.1.1.1"),
+					ForwarderProtocol: types.StringValue("Udp"),
+				}, diags)
+			},
+			want: []string{"forwarderProtocol", "forwarders"},
+		},
+		{
+			name: "recursion",
+			set: func(ctx context.Context, settings technitium.ApiSetSettingsRequest, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+				return setRecursion(ctx, settings, recursionResourceModel{
+					Recursion:       types.StringValue("Deny"),
+					ResolverRetries: types.Int32Value(3),
+				}, diags)
+			},
+			want: []string{"recursion", "resolverRetries"},
+		},
+		{
+			name: "cache settings",
+			set: func(ctx context.Context, settings technitium.ApiSetSettingsRequest, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+				return setCacheSettings(ctx, settings, cacheSettingsResourceModel{
+					ServeStale:          types.BoolValue(false),
+					CacheMaximumEntries: types.Int64Value(5000000000),
+				}, diags)
+			},
+			want: []string{"cacheMaximumEntries", "serveStale"},
+		},
+		{
+			name: "dns settings",
+			set: func(ctx context.Context, settings technitium.ApiSetSettingsRequest, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+				return setDnsSettings(ctx, settings, dnsSettingsResourceModel{
+					DefaultRecordTtl: types.Int32Value(600),
+				}, diags)
+			},
+			want: []string{"defaultRecordTtl"},
+		},
+		{
+			name: "block lists",
+			set: func(ctx context.Context, settings technitium.ApiSetSettingsRequest, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest {
+				return setBlockLists(ctx, settings, blockListsResourceModel{
+					BlockListURLs: testStringList(t, "https://example.com/hosts.txt"),
+					AllowListURLs: testStringList(t),
+				}, diags)
+			},
+			want: []string{"blockListUrls"},
+		},
+	}
+
+	for _, test := range tests {
+		sent := sentSettings(t, test.set)
+		got := make([]string, 0, len(sent))
+		for key := range sent {
+			got = append(got, key)
+		}
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: sent %v, expected %v", test.name, got, test.want)
+		}
+	}
+}
+
+// setSettingsFunc adds the settings of a model to a set settings request.
+type setSettingsFunc func(ctx context.Context, settings technitium.ApiSetSettingsRequest, diags *diag.Diagnostics) technitium.ApiSetSettingsRequest
+
+// sentSettings returns the parameters a set settings request sends.
+func sentSettings(t *testing.T, set setSettingsFunc) url.Values {
+	t.Helper()
+
+	var sent url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sent = r.Form
+		_, _ = io.WriteString(w, `{"status":"ok","response":{}}`)
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	client := technitium.NewAPIClient(&technitium.Configuration{
+		Servers: technitium.ServerConfigurations{{URL: server.URL}},
+	})
+	var diags diag.Diagnostics
+	answ, _, err := set(ctx, client.SettingsAPI.SetSettings(ctx), &diags).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return sent
+}
+
+// testStringList returns a list of strings, empty without values.
+func testStringList(t *testing.T, values ...string) types.List {
+	t.Helper()
+
+	if values == nil {
+		values = []string{}
+	}
+	list, diags := types.ListValueFrom(context.Background(), types.StringType, values)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return list
+}
@@ -0,0 +1,143 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// AdminAPIService manages users and other administrative objects.
+type AdminAPIService service
+
+type ApiListUsersRequest struct {
+	ctx        context.Context
+	ApiService *AdminAPIService
+}
+
+func (r ApiListUsersRequest) Execute() (*ListUsersResponse, *http.Response, error) {
+	return r.ApiService.ListUsersExecute(r)
+}
+
+// ListUsers lists the web console users.
+func (a *AdminAPIService) ListUsers(ctx context.Context) ApiListUsersRequest {
+	return ApiListUsersRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ListUsersExecute executes the request.
+func (a *AdminAPIService) ListUsersExecute(r ApiListUsersRequest) (*ListUsersResponse, *http.Response, error) {
+	return invoke[ListUsersResponse](r.ctx, a.client, http.MethodGet, "/api/admin/users/list", nil)
+}
+
+type ApiCreateUserRequest struct {
+	ctx        context.Context
+	ApiService *AdminAPIService
+	query      url.Values
+}
+
+// User sets the username.
+func (r ApiCreateUserRequest) User(user string) ApiCreateUserRequest {
+	r.query = withParam(r.query, "user", user)
+	return r
+}
+
+// Pass sets the password of the user.
+func (r ApiCreateUserRequest) Pass(pass string) ApiCreateUserRequest {
+	r.query = withParam(r.query, "pass", pass)
+	return r
+}
+
+// DisplayName sets the display name of the user.
+func (r ApiCreateUserRequest) DisplayName(displayName string) ApiCreateUserRequest {
+	r.query = withParam(r.query, "displayName", displayName)
+	return r
+}
+
+func (r ApiCreateUserRequest) Execute() (*UserResponse, *http.Response, error) {
+	return r.ApiService.CreateUserExecute(r)
+}
+
+// CreateUser creates a web console user.
+func (a *AdminAPIService) CreateUser(ctx context.Context) ApiCreateUserRequest {
+	return ApiCreateUserRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// CreateUserExecute executes the request.
+func (a *AdminAPIService) CreateUserExecute(r ApiCreateUserRequest) (*UserResponse, *http.Response, error) {
+	if err := requireParam(r.query, "user"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "pass"); err != nil {
+		return nil, nil, err
+	}
+	return invokeForm[UserResponse](r.ctx, a.client, "/api/admin/users/create", r.query)
+}
+
+type ApiGetUserRequest struct {
+	ctx        context.Context
+	ApiService *AdminAPIService
+	query      url.Values
+}
+
+// User sets the username.
+func (r ApiGetUserRequest) User(user string) ApiGetUserRequest {
+	r.query = withParam(r.query, "user", user)
+	return r
+}
+
+func (r ApiGetUserRequest) Execute() (*UserResponse, *http.Response, error) {
+	return r.ApiService.GetUserExecute(r)
+}
+
+// GetUser returns the details of a user.
+func (a *AdminAPIService) GetUser(ctx context.Context) ApiGetUserRequest {
+	return ApiGetUserRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// GetUserExecute executes the request.
+func (a *AdminAPIService) GetUserExecute(r ApiGetUserRequest) (*UserResponse, *http.Response, error) {
+	if err := requireParam(r.query, "user"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[UserResponse](r.ctx, a.client, http.MethodGet, "/api/admin/users/get", r.query)
+}
+
+type ApiDeleteUserRequest struct {
+	ctx        context.Context
+	ApiService *AdminAPIService
+	query      url.Values
+}
+
+// User sets the username.
+func (r ApiDeleteUserRequest) User(user string) ApiDeleteUserRequest {
+	r.query = withParam(r.query, "user", user)
+	return r
+}
+
+func (r ApiDeleteUserRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DeleteUserExecute(r)
+}
+
+// DeleteUser deletes a web console user.
+func (a *AdminAPIService) DeleteUser(ctx context.Context) ApiDeleteUserRequest {
+	return ApiDeleteUserRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DeleteUserExecute executes the request.
+func (a *AdminAPIService) DeleteUserExecute(r ApiDeleteUserRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "user"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/admin/users/delete", r.query)
+}
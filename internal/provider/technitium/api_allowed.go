@@ -0,0 +1,128 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// AllowedAPIService manages the allowed zone, the domains the server never blocks.
+type AllowedAPIService service
+
+type ApiListAllowedZonesRequest struct {
+	ctx        context.Context
+	ApiService *AllowedAPIService
+	query      url.Values
+}
+
+// Domain sets the domain to list, the root when not set.
+func (r ApiListAllowedZonesRequest) Domain(domain string) ApiListAllowedZonesRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+func (r ApiListAllowedZonesRequest) Execute() (*ListManualZonesResponse, *http.Response, error) {
+	return r.ApiService.ListAllowedZonesExecute(r)
+}
+
+// ListAllowedZones lists the subdomains and records of a domain of the allowed zone.
+func (a *AllowedAPIService) ListAllowedZones(ctx context.Context) ApiListAllowedZonesRequest {
+	return ApiListAllowedZonesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ListAllowedZonesExecute executes the request.
+func (a *AllowedAPIService) ListAllowedZonesExecute(r ApiListAllowedZonesRequest) (*ListManualZonesResponse, *http.Response, error) {
+	return invoke[ListManualZonesResponse](r.ctx, a.client, http.MethodGet, "/api/allowed/list", r.query)
+}
+
+type ApiExportAllowedZonesRequest struct {
+	ctx        context.Context
+	ApiService *AllowedAPIService
+}
+
+func (r ApiExportAllowedZonesRequest) Execute() ([]byte, *http.Response, error) {
+	return r.ApiService.ExportAllowedZonesExecute(r)
+}
+
+// ExportAllowedZones returns the domains of the allowed zone as a text file with
+// one domain per line.
+func (a *AllowedAPIService) ExportAllowedZones(ctx context.Context) ApiExportAllowedZonesRequest {
+	return ApiExportAllowedZonesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ExportAllowedZonesExecute executes the request.
+func (a *AllowedAPIService) ExportAllowedZonesExecute(r ApiExportAllowedZonesRequest) ([]byte, *http.Response, error) {
+	var out []byte
+	resp, err := a.client.callAPI(r.ctx, http.MethodGet, "/api/allowed/export", nil, nil, "", &out)
+	return out, resp, err
+}
+
+type ApiAddAllowedZoneRequest struct {
+	ctx        context.Context
+	ApiService *AllowedAPIService
+	query      url.Values
+}
+
+// Domain sets the domain to add.
+func (r ApiAddAllowedZoneRequest) Domain(domain string) ApiAddAllowedZoneRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+func (r ApiAddAllowedZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.AddAllowedZoneExecute(r)
+}
+
+// AddAllowedZone adds a domain to the allowed zone.
+func (a *AllowedAPIService) AddAllowedZone(ctx context.Context) ApiAddAllowedZoneRequest {
+	return ApiAddAllowedZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// AddAllowedZoneExecute executes the request.
+func (a *AllowedAPIService) AddAllowedZoneExecute(r ApiAddAllowedZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "domain"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/allowed/add", r.query)
+}
+
+type ApiDeleteAllowedZoneRequest struct {
+	ctx        context.Context
+	ApiService *AllowedAPIService
+	query      url.Values
+}
+
+// Domain sets the domain to remove.
+func (r ApiDeleteAllowedZoneRequest) Domain(domain string) ApiDeleteAllowedZoneRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+func (r ApiDeleteAllowedZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DeleteAllowedZoneExecute(r)
+}
+
+// DeleteAllowedZone removes a domain from the allowed zone.
+func (a *AllowedAPIService) DeleteAllowedZone(ctx context.Context) ApiDeleteAllowedZoneRequest {
+	return ApiDeleteAllowedZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DeleteAllowedZoneExecute executes the request.
+func (a *AllowedAPIService) DeleteAllowedZoneExecute(r ApiDeleteAllowedZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "domain"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/allowed/delete", r.query)
+}
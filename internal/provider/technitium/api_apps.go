@@ -0,0 +1,337 @@
+package technitium
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+// AppsAPIService manages DNS apps.
+type AppsAPIService service
+
+type ApiListAppsRequest struct {
+	ctx        context.Context
+	ApiService *AppsAPIService
+}
+
+func (r ApiListAppsRequest) Execute() (*ListAppsResponse, *http.Response, error) {
+	return r.ApiService.ListAppsExecute(r)
+}
+
+// ListApps lists the installed apps.
+func (a *AppsAPIService) ListApps(ctx context.Context) ApiListAppsRequest {
+	return ApiListAppsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ListAppsExecute executes the request.
+func (a *AppsAPIService) ListAppsExecute(r ApiListAppsRequest) (*ListAppsResponse, *http.Response, error) {
+	return invoke[ListAppsResponse](r.ctx, a.client, http.MethodGet, "/api/apps/list", nil)
+}
+
+type ApiListStoreAppsRequest struct {
+	ctx        context.Context
+	ApiService *AppsAPIService
+}
+
+func (r ApiListStoreAppsRequest) Execute() (*ListStoreAppsResponse, *http.Response, error) {
+	return r.ApiService.ListStoreAppsExecute(r)
+}
+
+// ListStoreApps lists the apps of the DNS app store.
+func (a *AppsAPIService) ListStoreApps(ctx context.Context) ApiListStoreAppsRequest {
+	return ApiListStoreAppsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ListStoreAppsExecute executes the request.
+func (a *AppsAPIService) ListStoreAppsExecute(r ApiListStoreAppsRequest) (*ListStoreAppsResponse, *http.Response, error) {
+	return invoke[ListStoreAppsResponse](r.ctx, a.client, http.MethodGet, "/api/apps/listStoreApps", nil)
+}
+
+type ApiDownloadAndInstallAppRequest struct {
+	ctx        context.Context
+	ApiService *AppsAPIService
+	query      url.Values
+}
+
+// Name sets the name of the app.
+func (r ApiDownloadAndInstallAppRequest) Name(name string) ApiDownloadAndInstallAppRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// Url sets the download URL of the app zip file.
+func (r ApiDownloadAndInstallAppRequest) Url(url string) ApiDownloadAndInstallAppRequest {
+	r.query = withParam(r.query, "url", url)
+	return r
+}
+
+func (r ApiDownloadAndInstallAppRequest) Execute() (*InstallAppResponse, *http.Response, error) {
+	return r.ApiService.DownloadAndInstallAppExecute(r)
+}
+
+// DownloadAndInstallApp installs an app from a URL, usually one from the app store.
+func (a *AppsAPIService) DownloadAndInstallApp(ctx context.Context) ApiDownloadAndInstallAppRequest {
+	return ApiDownloadAndInstallAppRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DownloadAndInstallAppExecute executes the request.
+func (a *AppsAPIService) DownloadAndInstallAppExecute(r ApiDownloadAndInstallAppRequest) (*InstallAppResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "url"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[InstallAppResponse](r.ctx, a.client, http.MethodGet, "/api/apps/downloadAndInstall", r.query)
+}
+
+type ApiDownloadAndUpdateAppRequest struct {
+	ctx        context.Context
+	ApiService *AppsAPIService
+	query      url.Values
+}
+
+// Name sets the name of the app.
+func (r ApiDownloadAndUpdateAppRequest) Name(name string) ApiDownloadAndUpdateAppRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// Url sets the download URL of the app zip file.
+func (r ApiDownloadAndUpdateAppRequest) Url(url string) ApiDownloadAndUpdateAppRequest {
+	r.query = withParam(r.query, "url", url)
+	return r
+}
+
+func (r ApiDownloadAndUpdateAppRequest) Execute() (*InstallAppResponse, *http.Response, error) {
+	return r.ApiService.DownloadAndUpdateAppExecute(r)
+}
+
+// DownloadAndUpdateApp updates an installed app from a URL.
+func (a *AppsAPIService) DownloadAndUpdateApp(ctx context.Context) ApiDownloadAndUpdateAppRequest {
+	return ApiDownloadAndUpdateAppRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DownloadAndUpdateAppExecute executes the request.
+func (a *AppsAPIService) DownloadAndUpdateAppExecute(r ApiDownloadAndUpdateAppRequest) (*InstallAppResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "url"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[InstallAppResponse](r.ctx, a.client, http.MethodGet, "/api/apps/downloadAndUpdate", r.query)
+}
+
+type ApiUninstallAppRequest struct {
+	ctx        context.Context
+	ApiService *AppsAPIService
+	query      url.Values
+}
+
+// Name sets the name of the app.
+func (r ApiUninstallAppRequest) Name(name string) ApiUninstallAppRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+func (r ApiUninstallAppRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.UninstallAppExecute(r)
+}
+
+// UninstallApp uninstalls an app.
+func (a *AppsAPIService) UninstallApp(ctx context.Context) ApiUninstallAppRequest {
+	return ApiUninstallAppRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// UninstallAppExecute executes the request.
+func (a *AppsAPIService) UninstallAppExecute(r ApiUninstallAppRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/apps/uninstall", r.query)
+}
+
+type ApiGetAppConfigRequest struct {
+	ctx        context.Context
+	ApiService *AppsAPIService
+	query      url.Values
+}
+
+// Name sets the name of the app.
+func (r ApiGetAppConfigRequest) Name(name string) ApiGetAppConfigRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+func (r ApiGetAppConfigRequest) Execute() (*AppConfigResponse, *http.Response, error) {
+	return r.ApiService.GetAppConfigExecute(r)
+}
+
+// GetAppConfig returns the config file content of an app.
+func (a *AppsAPIService) GetAppConfig(ctx context.Context) ApiGetAppConfigRequest {
+	return ApiGetAppConfigRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// GetAppConfigExecute executes the request.
+func (a *AppsAPIService) GetAppConfigExecute(r ApiGetAppConfigRequest) (*AppConfigResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[AppConfigResponse](r.ctx, a.client, http.MethodGet, "/api/apps/config/get", r.query)
+}
+
+type ApiSetAppConfigRequest struct {
+	ctx        context.Context
+	ApiService *AppsAPIService
+	query      url.Values
+}
+
+// Name sets the name of the app.
+func (r ApiSetAppConfigRequest) Name(name string) ApiSetAppConfigRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// Config sets the config file content.
+func (r ApiSetAppConfigRequest) Config(config string) ApiSetAppConfigRequest {
+	r.query = withParam(r.query, "config", config)
+	return r
+}
+
+func (r ApiSetAppConfigRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.SetAppConfigExecute(r)
+}
+
+// SetAppConfig replaces the config file content of an app.
+func (a *AppsAPIService) SetAppConfig(ctx context.Context) ApiSetAppConfigRequest {
+	return ApiSetAppConfigRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// SetAppConfigExecute executes the request.
+func (a *AppsAPIService) SetAppConfigExecute(r ApiSetAppConfigRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	return invokeForm[StatusResponse](r.ctx, a.client, "/api/apps/config/set", r.query)
+}
+
+type ApiInstallAppRequest struct {
+	ctx        context.Context
+	ApiService *AppsAPIService
+	query      url.Values
+	file       []byte
+}
+
+// Name sets the name of the app.
+func (r ApiInstallAppRequest) Name(name string) ApiInstallAppRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// File sets the content of the app zip file.
+func (r ApiInstallAppRequest) File(file []byte) ApiInstallAppRequest {
+	r.file = file
+	return r
+}
+
+func (r ApiInstallAppRequest) Execute() (*InstallAppResponse, *http.Response, error) {
+	return r.ApiService.InstallAppExecute(r)
+}
+
+// InstallApp installs an app from an uploaded zip file.
+func (a *AppsAPIService) InstallApp(ctx context.Context) ApiInstallAppRequest {
+	return ApiInstallAppRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// InstallAppExecute executes the request.
+func (a *AppsAPIService) InstallAppExecute(r ApiInstallAppRequest) (*InstallAppResponse, *http.Response, error) {
+	return a.upload(r.ctx, "/api/apps/install", r.query, r.file)
+}
+
+type ApiUpdateAppRequest struct {
+	ctx        context.Context
+	ApiService *AppsAPIService
+	query      url.Values
+	file       []byte
+}
+
+// Name sets the name of the app.
+func (r ApiUpdateAppRequest) Name(name string) ApiUpdateAppRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// File sets the content of the app zip file.
+func (r ApiUpdateAppRequest) File(file []byte) ApiUpdateAppRequest {
+	r.file = file
+	return r
+}
+
+func (r ApiUpdateAppRequest) Execute() (*InstallAppResponse, *http.Response, error) {
+	return r.ApiService.UpdateAppExecute(r)
+}
+
+// UpdateApp updates an installed app from an uploaded zip file.
+func (a *AppsAPIService) UpdateApp(ctx context.Context) ApiUpdateAppRequest {
+	return ApiUpdateAppRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// UpdateAppExecute executes the request.
+func (a *AppsAPIService) UpdateAppExecute(r ApiUpdateAppRequest) (*InstallAppResponse, *http.Response, error) {
+	return a.upload(r.ctx, "/api/apps/update", r.query, r.file)
+}
+
+// upload posts an app zip file as a multipart form.
+func (a *AppsAPIService) upload(ctx context.Context, path string, query url.Values, file []byte) (*InstallAppResponse, *http.Response, error) {
+	if err := requireParam(query, "name"); err != nil {
+		return nil, nil, err
+	}
+	if len(file) == 0 {
+		return nil, nil, reportError("file is required and must be specified")
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", query.Get("name")+".zip")
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := part.Write(file); err != nil {
+		return nil, nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return invokeWithBody[InstallAppResponse](ctx, a.client, path, query, body.Bytes(), w.FormDataContentType())
+}
@@ -0,0 +1,128 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// BlockedAPIService manages the blocked zone, the domains the server always blocks.
+type BlockedAPIService service
+
+type ApiListBlockedZonesRequest struct {
+	ctx        context.Context
+	ApiService *BlockedAPIService
+	query      url.Values
+}
+
+// Domain sets the domain to list, the root when not set.
+func (r ApiListBlockedZonesRequest) Domain(domain string) ApiListBlockedZonesRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+func (r ApiListBlockedZonesRequest) Execute() (*ListManualZonesResponse, *http.Response, error) {
+	return r.ApiService.ListBlockedZonesExecute(r)
+}
+
+// ListBlockedZones lists the subdomains and records of a domain of the blocked zone.
+func (a *BlockedAPIService) ListBlockedZones(ctx context.Context) ApiListBlockedZonesRequest {
+	return ApiListBlockedZonesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ListBlockedZonesExecute executes the request.
+func (a *BlockedAPIService) ListBlockedZonesExecute(r ApiListBlockedZonesRequest) (*ListManualZonesResponse, *http.Response, error) {
+	return invoke[ListManualZonesResponse](r.ctx, a.client, http.MethodGet, "/api/blocked/list", r.query)
+}
+
+type ApiExportBlockedZonesRequest struct {
+	ctx        context.Context
+	ApiService *BlockedAPIService
+}
+
+func (r ApiExportBlockedZonesRequest) Execute() ([]byte, *http.Response, error) {
+	return r.ApiService.ExportBlockedZonesExecute(r)
+}
+
+// ExportBlockedZones returns the domains of the blocked zone as a text file with
+// one domain per line.
+func (a *BlockedAPIService) ExportBlockedZones(ctx context.Context) ApiExportBlockedZonesRequest {
+	return ApiExportBlockedZonesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ExportBlockedZonesExecute executes the request.
+func (a *BlockedAPIService) ExportBlockedZonesExecute(r ApiExportBlockedZonesRequest) ([]byte, *http.Response, error) {
+	var out []byte
+	resp, err := a.client.callAPI(r.ctx, http.MethodGet, "/api/blocked/export", nil, nil, "", &out)
+	return out, resp, err
+}
+
+type ApiAddBlockedZoneRequest struct {
+	ctx        context.Context
+	ApiService *BlockedAPIService
+	query      url.Values
+}
+
+// Domain sets the domain to add.
+func (r ApiAddBlockedZoneRequest) Domain(domain string) ApiAddBlockedZoneRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+func (r ApiAddBlockedZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.AddBlockedZoneExecute(r)
+}
+
+// AddBlockedZone adds a domain to the blocked zone.
+func (a *BlockedAPIService) AddBlockedZone(ctx context.Context) ApiAddBlockedZoneRequest {
+	return ApiAddBlockedZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// AddBlockedZoneExecute executes the request.
+func (a *BlockedAPIService) AddBlockedZoneExecute(r ApiAddBlockedZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "domain"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/blocked/add", r.query)
+}
+
+type ApiDeleteBlockedZoneRequest struct {
+	ctx        context.Context
+	ApiService *BlockedAPIService
+	query      url.Values
+}
+
+// Domain sets the domain to remove.
+func (r ApiDeleteBlockedZoneRequest) Domain(domain string) ApiDeleteBlockedZoneRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+func (r ApiDeleteBlockedZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DeleteBlockedZoneExecute(r)
+}
+
+// DeleteBlockedZone removes a domain from the blocked zone.
+func (a *BlockedAPIService) DeleteBlockedZone(ctx context.Context) ApiDeleteBlockedZoneRequest {
+	return ApiDeleteBlockedZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DeleteBlockedZoneExecute executes the request.
+func (a *BlockedAPIService) DeleteBlockedZoneExecute(r ApiDeleteBlockedZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "domain"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/blocked/delete", r.query)
+}
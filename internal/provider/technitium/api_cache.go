@@ -0,0 +1,64 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// CacheAPIService manages the DNS cache of the server.
+type CacheAPIService service
+
+type ApiFlushCacheRequest struct {
+	ctx        context.Context
+	ApiService *CacheAPIService
+}
+
+func (r ApiFlushCacheRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.FlushCacheExecute(r)
+}
+
+// FlushCache removes every entry from the DNS cache.
+func (a *CacheAPIService) FlushCache(ctx context.Context) ApiFlushCacheRequest {
+	return ApiFlushCacheRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// FlushCacheExecute executes the request.
+func (a *CacheAPIService) FlushCacheExecute(r ApiFlushCacheRequest) (*StatusResponse, *http.Response, error) {
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/cache/flush", nil)
+}
+
+type ApiDeleteCachedZoneRequest struct {
+	ctx        context.Context
+	ApiService *CacheAPIService
+	query      url.Values
+}
+
+// Domain sets the domain to remove from the cache.
+func (r ApiDeleteCachedZoneRequest) Domain(domain string) ApiDeleteCachedZoneRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+func (r ApiDeleteCachedZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DeleteCachedZoneExecute(r)
+}
+
+// DeleteCachedZone removes a domain and its subdomains from the DNS cache.
+func (a *CacheAPIService) DeleteCachedZone(ctx context.Context) ApiDeleteCachedZoneRequest {
+	return ApiDeleteCachedZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DeleteCachedZoneExecute executes the request.
+func (a *CacheAPIService) DeleteCachedZoneExecute(r ApiDeleteCachedZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "domain"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/cache/delete", r.query)
+}
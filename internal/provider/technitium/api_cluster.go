@@ -0,0 +1,292 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// ClusterAPIService manages clustering of servers, available from version 14.
+type ClusterAPIService service
+
+type ApiGetClusterStateRequest struct {
+	ctx        context.Context
+	ApiService *ClusterAPIService
+	query      url.Values
+}
+
+// IncludeServerIpAddresses adds the IP addresses of the server to the answer.
+func (r ApiGetClusterStateRequest) IncludeServerIpAddresses(includeServerIpAddresses bool) ApiGetClusterStateRequest {
+	r.query = withParam(r.query, "includeServerIpAddresses", formatBool(includeServerIpAddresses))
+	return r
+}
+
+func (r ApiGetClusterStateRequest) Execute() (*ClusterStateResponse, *http.Response, error) {
+	return r.ApiService.GetClusterStateExecute(r)
+}
+
+// GetClusterState returns the cluster configuration and the state of its nodes.
+func (a *ClusterAPIService) GetClusterState(ctx context.Context) ApiGetClusterStateRequest {
+	return ApiGetClusterStateRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// GetClusterStateExecute executes the request.
+func (a *ClusterAPIService) GetClusterStateExecute(r ApiGetClusterStateRequest) (*ClusterStateResponse, *http.Response, error) {
+	return invoke[ClusterStateResponse](r.ctx, a.client, http.MethodGet, "/api/admin/cluster/state", r.query)
+}
+
+type ApiInitClusterRequest struct {
+	ctx        context.Context
+	ApiService *ClusterAPIService
+	query      url.Values
+}
+
+// ClusterDomain sets the domain name under which the cluster nodes are published.
+func (r ApiInitClusterRequest) ClusterDomain(clusterDomain string) ApiInitClusterRequest {
+	r.query = withParam(r.query, "clusterDomain", clusterDomain)
+	return r
+}
+
+// PrimaryNodeIpAddresses sets the addresses other nodes reach this server on.
+func (r ApiInitClusterRequest) PrimaryNodeIpAddresses(primaryNodeIpAddresses []string) ApiInitClusterRequest {
+	r.query = withParam(r.query, "primaryNodeIpAddresses", formatList(primaryNodeIpAddresses))
+	return r
+}
+
+func (r ApiInitClusterRequest) Execute() (*ClusterStateResponse, *http.Response, error) {
+	return r.ApiService.InitClusterExecute(r)
+}
+
+// InitCluster makes this server the primary node of a new cluster.
+func (a *ClusterAPIService) InitCluster(ctx context.Context) ApiInitClusterRequest {
+	return ApiInitClusterRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// InitClusterExecute executes the request.
+func (a *ClusterAPIService) InitClusterExecute(r ApiInitClusterRequest) (*ClusterStateResponse, *http.Response, error) {
+	if err := requireParam(r.query, "clusterDomain"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "primaryNodeIpAddresses"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[ClusterStateResponse](r.ctx, a.client, http.MethodGet, "/api/admin/cluster/init", r.query)
+}
+
+type ApiDeleteClusterRequest struct {
+	ctx        context.Context
+	ApiService *ClusterAPIService
+	query      url.Values
+}
+
+// ForceDelete deletes the cluster even when secondary nodes are still joined.
+func (r ApiDeleteClusterRequest) ForceDelete(forceDelete bool) ApiDeleteClusterRequest {
+	r.query = withParam(r.query, "forceDelete", formatBool(forceDelete))
+	return r
+}
+
+func (r ApiDeleteClusterRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DeleteClusterExecute(r)
+}
+
+// DeleteCluster deletes the cluster on its primary node.
+func (a *ClusterAPIService) DeleteCluster(ctx context.Context) ApiDeleteClusterRequest {
+	return ApiDeleteClusterRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DeleteClusterExecute executes the request.
+func (a *ClusterAPIService) DeleteClusterExecute(r ApiDeleteClusterRequest) (*StatusResponse, *http.Response, error) {
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/admin/cluster/primary/delete", r.query)
+}
+
+type ApiSetClusterOptionsRequest struct {
+	ctx        context.Context
+	ApiService *ClusterAPIService
+	query      url.Values
+}
+
+// HeartbeatRefreshIntervalSeconds sets how often nodes check each other.
+func (r ApiSetClusterOptionsRequest) HeartbeatRefreshIntervalSeconds(heartbeatRefreshIntervalSeconds int32) ApiSetClusterOptionsRequest {
+	r.query = withParam(r.query, "heartbeatRefreshIntervalSeconds", formatInt(int64(heartbeatRefreshIntervalSeconds)))
+	return r
+}
+
+// HeartbeatRetryIntervalSeconds sets the delay before a failed heartbeat is retried.
+func (r ApiSetClusterOptionsRequest) HeartbeatRetryIntervalSeconds(heartbeatRetryIntervalSeconds int32) ApiSetClusterOptionsRequest {
+	r.query = withParam(r.query, "heartbeatRetryIntervalSeconds", formatInt(int64(heartbeatRetryIntervalSeconds)))
+	return r
+}
+
+// ConfigRefreshIntervalSeconds sets how often secondary nodes sync the configuration.
+func (r ApiSetClusterOptionsRequest) ConfigRefreshIntervalSeconds(configRefreshIntervalSeconds int32) ApiSetClusterOptionsRequest {
+	r.query = withParam(r.query, "configRefreshIntervalSeconds", formatInt(int64(configRefreshIntervalSeconds)))
+	return r
+}
+
+// ConfigRetryIntervalSeconds sets the delay before a failed sync is retried.
+func (r ApiSetClusterOptionsRequest) ConfigRetryIntervalSeconds(configRetryIntervalSeconds int32) ApiSetClusterOptionsRequest {
+	r.query = withParam(r.query, "configRetryIntervalSeconds", formatInt(int64(configRetryIntervalSeconds)))
+	return r
+}
+
+func (r ApiSetClusterOptionsRequest) Execute() (*ClusterStateResponse, *http.Response, error) {
+	return r.ApiService.SetClusterOptionsExecute(r)
+}
+
+// SetClusterOptions sets the timing options of the cluster on its primary node.
+func (a *ClusterAPIService) SetClusterOptions(ctx context.Context) ApiSetClusterOptionsRequest {
+	return ApiSetClusterOptionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// SetClusterOptionsExecute executes the request.
+func (a *ClusterAPIService) SetClusterOptionsExecute(r ApiSetClusterOptionsRequest) (*ClusterStateResponse, *http.Response, error) {
+	return invoke[ClusterStateResponse](r.ctx, a.client, http.MethodGet, "/api/admin/cluster/primary/setOptions", r.query)
+}
+
+type ApiRemoveSecondaryNodeRequest struct {
+	ctx        context.Context
+	ApiService *ClusterAPIService
+	query      url.Values
+}
+
+// SecondaryNodeId sets the ID of the node to remove.
+func (r ApiRemoveSecondaryNodeRequest) SecondaryNodeId(secondaryNodeId int32) ApiRemoveSecondaryNodeRequest {
+	r.query = withParam(r.query, "secondaryNodeId", formatInt(int64(secondaryNodeId)))
+	return r
+}
+
+func (r ApiRemoveSecondaryNodeRequest) Execute() (*ClusterStateResponse, *http.Response, error) {
+	return r.ApiService.RemoveSecondaryNodeExecute(r)
+}
+
+// RemoveSecondaryNode removes a secondary node from the cluster on the primary node.
+func (a *ClusterAPIService) RemoveSecondaryNode(ctx context.Context) ApiRemoveSecondaryNodeRequest {
+	return ApiRemoveSecondaryNodeRequest{ApiService: a, ctx: ctx}
+}
+
+// RemoveSecondaryNodeExecute executes the request.
+func (a *ClusterAPIService) RemoveSecondaryNodeExecute(r ApiRemoveSecondaryNodeRequest) (*ClusterStateResponse, *http.Response, error) {
+	if err := requireParam(r.query, "secondaryNodeId"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[ClusterStateResponse](r.ctx, a.client, http.MethodGet, "/api/admin/cluster/primary/removeSecondary", r.query)
+}
+
+type ApiJoinClusterRequest struct {
+	ctx        context.Context
+	ApiService *ClusterAPIService
+	query      url.Values
+}
+
+// SecondaryNodeIpAddresses sets the addresses other nodes reach this server on.
+func (r ApiJoinClusterRequest) SecondaryNodeIpAddresses(secondaryNodeIpAddresses []string) ApiJoinClusterRequest {
+	r.query = withParam(r.query, "secondaryNodeIpAddresses", formatList(secondaryNodeIpAddresses))
+	return r
+}
+
+// PrimaryNodeUrl sets the web service URL of the primary node.
+func (r ApiJoinClusterRequest) PrimaryNodeUrl(primaryNodeUrl string) ApiJoinClusterRequest {
+	r.query = withParam(r.query, "primaryNodeUrl", primaryNodeUrl)
+	return r
+}
+
+// PrimaryNodeIpAddress sets the address to reach the primary node on, instead of resolving its URL.
+func (r ApiJoinClusterRequest) PrimaryNodeIpAddress(primaryNodeIpAddress string) ApiJoinClusterRequest {
+	r.query = withParam(r.query, "primaryNodeIpAddress", primaryNodeIpAddress)
+	return r
+}
+
+// PrimaryNodeUsername sets the administrator to log in to the primary node as.
+func (r ApiJoinClusterRequest) PrimaryNodeUsername(primaryNodeUsername string) ApiJoinClusterRequest {
+	r.query = withParam(r.query, "primaryNodeUsername", primaryNodeUsername)
+	return r
+}
+
+// PrimaryNodePassword sets the password of the primary node administrator.
+func (r ApiJoinClusterRequest) PrimaryNodePassword(primaryNodePassword string) ApiJoinClusterRequest {
+	r.query = withParam(r.query, "primaryNodePassword", primaryNodePassword)
+	return r
+}
+
+// PrimaryNodeTotp sets the one-time password when the administrator uses two-factor authentication.
+func (r ApiJoinClusterRequest) PrimaryNodeTotp(primaryNodeTotp string) ApiJoinClusterRequest {
+	r.query = withParam(r.query, "primaryNodeTotp", primaryNodeTotp)
+	return r
+}
+
+// IgnoreCertificateErrors accepts any certificate from the primary node.
+func (r ApiJoinClusterRequest) IgnoreCertificateErrors(ignoreCertificateErrors bool) ApiJoinClusterRequest {
+	r.query = withParam(r.query, "ignoreCertificateErrors", formatBool(ignoreCertificateErrors))
+	return r
+}
+
+func (r ApiJoinClusterRequest) Execute() (*ClusterStateResponse, *http.Response, error) {
+	return r.ApiService.JoinClusterExecute(r)
+}
+
+// JoinCluster joins this server to a cluster as a secondary node. The
+// credentials of the primary node are sent as a form body.
+func (a *ClusterAPIService) JoinCluster(ctx context.Context) ApiJoinClusterRequest {
+	return ApiJoinClusterRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// JoinClusterExecute executes the request.
+func (a *ClusterAPIService) JoinClusterExecute(r ApiJoinClusterRequest) (*ClusterStateResponse, *http.Response, error) {
+	if err := requireParam(r.query, "secondaryNodeIpAddresses"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "primaryNodeUrl"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "primaryNodeUsername"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "primaryNodePassword"); err != nil {
+		return nil, nil, err
+	}
+	return invokeForm[ClusterStateResponse](r.ctx, a.client, "/api/admin/cluster/initJoin", r.query)
+}
+
+type ApiLeaveClusterRequest struct {
+	ctx        context.Context
+	ApiService *ClusterAPIService
+	query      url.Values
+}
+
+// ForceLeave leaves the cluster even when the primary node cannot be reached.
+func (r ApiLeaveClusterRequest) ForceLeave(forceLeave bool) ApiLeaveClusterRequest {
+	r.query = withParam(r.query, "forceLeave", formatBool(forceLeave))
+	return r
+}
+
+func (r ApiLeaveClusterRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.LeaveClusterExecute(r)
+}
+
+// LeaveCluster removes this secondary node from its cluster.
+func (a *ClusterAPIService) LeaveCluster(ctx context.Context) ApiLeaveClusterRequest {
+	return ApiLeaveClusterRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// LeaveClusterExecute executes the request.
+func (a *ClusterAPIService) LeaveClusterExecute(r ApiLeaveClusterRequest) (*StatusResponse, *http.Response, error) {
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/admin/cluster/secondary/leave", r.query)
+}
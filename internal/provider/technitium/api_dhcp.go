@@ -0,0 +1,563 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// DhcpAPIService manages the DHCP server.
+type DhcpAPIService service
+
+type ApiListDhcpLeasesRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+}
+
+func (r ApiListDhcpLeasesRequest) Execute() (*ListDhcpLeasesResponse, *http.Response, error) {
+	return r.ApiService.ListDhcpLeasesExecute(r)
+}
+
+// ListDhcpLeases lists the leases of all scopes.
+func (a *DhcpAPIService) ListDhcpLeases(ctx context.Context) ApiListDhcpLeasesRequest {
+	return ApiListDhcpLeasesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ListDhcpLeasesExecute executes the request.
+func (a *DhcpAPIService) ListDhcpLeasesExecute(r ApiListDhcpLeasesRequest) (*ListDhcpLeasesResponse, *http.Response, error) {
+	return invoke[ListDhcpLeasesResponse](r.ctx, a.client, http.MethodGet, "/api/dhcp/leases/list", nil)
+}
+
+type ApiRemoveDhcpLeaseRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+	query      url.Values
+}
+
+// Name sets the name of the scope.
+func (r ApiRemoveDhcpLeaseRequest) Name(name string) ApiRemoveDhcpLeaseRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// HardwareAddress sets the hardware address of the lease.
+func (r ApiRemoveDhcpLeaseRequest) HardwareAddress(hardwareAddress string) ApiRemoveDhcpLeaseRequest {
+	r.query = withParam(r.query, "hardwareAddress", hardwareAddress)
+	return r
+}
+
+func (r ApiRemoveDhcpLeaseRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.RemoveDhcpLeaseExecute(r)
+}
+
+// RemoveDhcpLease removes a dynamic lease.
+func (a *DhcpAPIService) RemoveDhcpLease(ctx context.Context) ApiRemoveDhcpLeaseRequest {
+	return ApiRemoveDhcpLeaseRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// RemoveDhcpLeaseExecute executes the request.
+func (a *DhcpAPIService) RemoveDhcpLeaseExecute(r ApiRemoveDhcpLeaseRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "hardwareAddress"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/dhcp/leases/remove", r.query)
+}
+
+type ApiListDhcpScopesRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+}
+
+func (r ApiListDhcpScopesRequest) Execute() (*ListDhcpScopesResponse, *http.Response, error) {
+	return r.ApiService.ListDhcpScopesExecute(r)
+}
+
+// ListDhcpScopes lists the DHCP scopes.
+func (a *DhcpAPIService) ListDhcpScopes(ctx context.Context) ApiListDhcpScopesRequest {
+	return ApiListDhcpScopesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ListDhcpScopesExecute executes the request.
+func (a *DhcpAPIService) ListDhcpScopesExecute(r ApiListDhcpScopesRequest) (*ListDhcpScopesResponse, *http.Response, error) {
+	return invoke[ListDhcpScopesResponse](r.ctx, a.client, http.MethodGet, "/api/dhcp/scopes/list", nil)
+}
+
+type ApiGetDhcpScopeRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+	query      url.Values
+}
+
+// Name sets the name of the scope.
+func (r ApiGetDhcpScopeRequest) Name(name string) ApiGetDhcpScopeRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+func (r ApiGetDhcpScopeRequest) Execute() (*DhcpScopeResponse, *http.Response, error) {
+	return r.ApiService.GetDhcpScopeExecute(r)
+}
+
+// GetDhcpScope returns the configuration of a scope.
+func (a *DhcpAPIService) GetDhcpScope(ctx context.Context) ApiGetDhcpScopeRequest {
+	return ApiGetDhcpScopeRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// GetDhcpScopeExecute executes the request.
+func (a *DhcpAPIService) GetDhcpScopeExecute(r ApiGetDhcpScopeRequest) (*DhcpScopeResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[DhcpScopeResponse](r.ctx, a.client, http.MethodGet, "/api/dhcp/scopes/get", r.query)
+}
+
+type ApiSetDhcpScopeRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+	query      url.Values
+}
+
+// Name sets the name of the scope.
+func (r ApiSetDhcpScopeRequest) Name(name string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// NewName renames the scope.
+func (r ApiSetDhcpScopeRequest) NewName(newName string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "newName", newName)
+	return r
+}
+
+// StartingAddress sets the first address of the scope.
+func (r ApiSetDhcpScopeRequest) StartingAddress(startingAddress string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "startingAddress", startingAddress)
+	return r
+}
+
+// EndingAddress sets the last address of the scope.
+func (r ApiSetDhcpScopeRequest) EndingAddress(endingAddress string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "endingAddress", endingAddress)
+	return r
+}
+
+// SubnetMask sets the subnet mask of the scope.
+func (r ApiSetDhcpScopeRequest) SubnetMask(subnetMask string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "subnetMask", subnetMask)
+	return r
+}
+
+// LeaseTimeDays sets the days part of the lease time.
+func (r ApiSetDhcpScopeRequest) LeaseTimeDays(leaseTimeDays int32) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "leaseTimeDays", formatInt(int64(leaseTimeDays)))
+	return r
+}
+
+// LeaseTimeHours sets the hours part of the lease time.
+func (r ApiSetDhcpScopeRequest) LeaseTimeHours(leaseTimeHours int32) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "leaseTimeHours", formatInt(int64(leaseTimeHours)))
+	return r
+}
+
+// LeaseTimeMinutes sets the minutes part of the lease time.
+func (r ApiSetDhcpScopeRequest) LeaseTimeMinutes(leaseTimeMinutes int32) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "leaseTimeMinutes", formatInt(int64(leaseTimeMinutes)))
+	return r
+}
+
+// OfferDelayTime sets the delay in milliseconds before sending an offer.
+func (r ApiSetDhcpScopeRequest) OfferDelayTime(offerDelayTime int32) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "offerDelayTime", formatInt(int64(offerDelayTime)))
+	return r
+}
+
+// PingCheckEnabled pings an address before offering it.
+func (r ApiSetDhcpScopeRequest) PingCheckEnabled(pingCheckEnabled bool) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "pingCheckEnabled", formatBool(pingCheckEnabled))
+	return r
+}
+
+// PingCheckTimeout sets the ping check timeout in milliseconds.
+func (r ApiSetDhcpScopeRequest) PingCheckTimeout(pingCheckTimeout int32) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "pingCheckTimeout", formatInt(int64(pingCheckTimeout)))
+	return r
+}
+
+// PingCheckRetries sets the ping check retries.
+func (r ApiSetDhcpScopeRequest) PingCheckRetries(pingCheckRetries int32) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "pingCheckRetries", formatInt(int64(pingCheckRetries)))
+	return r
+}
+
+// DomainName sets the domain name option.
+func (r ApiSetDhcpScopeRequest) DomainName(domainName string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "domainName", domainName)
+	return r
+}
+
+// DomainSearchList sets the domain search list option.
+func (r ApiSetDhcpScopeRequest) DomainSearchList(domainSearchList []string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "domainSearchList", formatList(domainSearchList))
+	return r
+}
+
+// DnsUpdates registers leases in the domain name zone.
+func (r ApiSetDhcpScopeRequest) DnsUpdates(dnsUpdates bool) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "dnsUpdates", formatBool(dnsUpdates))
+	return r
+}
+
+// DnsTtl sets the TTL of records registered for leases.
+func (r ApiSetDhcpScopeRequest) DnsTtl(dnsTtl int32) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "dnsTtl", formatInt(int64(dnsTtl)))
+	return r
+}
+
+// ServerAddress sets the next server address for network boot.
+func (r ApiSetDhcpScopeRequest) ServerAddress(serverAddress string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "serverAddress", serverAddress)
+	return r
+}
+
+// ServerHostName sets the next server host name for network boot.
+func (r ApiSetDhcpScopeRequest) ServerHostName(serverHostName string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "serverHostName", serverHostName)
+	return r
+}
+
+// BootFileName sets the boot file name for network boot.
+func (r ApiSetDhcpScopeRequest) BootFileName(bootFileName string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "bootFileName", bootFileName)
+	return r
+}
+
+// RouterAddress sets the default gateway option.
+func (r ApiSetDhcpScopeRequest) RouterAddress(routerAddress string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "routerAddress", routerAddress)
+	return r
+}
+
+// UseThisDnsServer advertises this server as the DNS server.
+func (r ApiSetDhcpScopeRequest) UseThisDnsServer(useThisDnsServer bool) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "useThisDnsServer", formatBool(useThisDnsServer))
+	return r
+}
+
+// DnsServers sets the DNS servers option.
+func (r ApiSetDhcpScopeRequest) DnsServers(dnsServers []string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "dnsServers", formatList(dnsServers))
+	return r
+}
+
+// WinsServers sets the WINS servers option.
+func (r ApiSetDhcpScopeRequest) WinsServers(winsServers []string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "winsServers", formatList(winsServers))
+	return r
+}
+
+// NtpServers sets the NTP servers option.
+func (r ApiSetDhcpScopeRequest) NtpServers(ntpServers []string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "ntpServers", formatList(ntpServers))
+	return r
+}
+
+// NtpServerDomainNames sets NTP server names resolved into the NTP servers option.
+func (r ApiSetDhcpScopeRequest) NtpServerDomainNames(ntpServerDomainNames []string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "ntpServerDomainNames", formatList(ntpServerDomainNames))
+	return r
+}
+
+// StaticRoutes sets the static routes as destination, subnet mask and router rows.
+func (r ApiSetDhcpScopeRequest) StaticRoutes(staticRoutes [][]string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "staticRoutes", formatTable(staticRoutes))
+	return r
+}
+
+// VendorInfo sets the vendor information as identifier and information rows.
+func (r ApiSetDhcpScopeRequest) VendorInfo(vendorInfo [][]string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "vendorInfo", formatTable(vendorInfo))
+	return r
+}
+
+// CapwapAcIpAddresses sets the CAPWAP access controller addresses option.
+func (r ApiSetDhcpScopeRequest) CapwapAcIpAddresses(capwapAcIpAddresses []string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "capwapAcIpAddresses", formatList(capwapAcIpAddresses))
+	return r
+}
+
+// TftpServerAddresses sets the TFTP server addresses option.
+func (r ApiSetDhcpScopeRequest) TftpServerAddresses(tftpServerAddresses []string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "tftpServerAddresses", formatList(tftpServerAddresses))
+	return r
+}
+
+// GenericOptions sets raw options as code and hex value rows.
+func (r ApiSetDhcpScopeRequest) GenericOptions(genericOptions [][]string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "genericOptions", formatTable(genericOptions))
+	return r
+}
+
+// Exclusions sets the excluded ranges as starting and ending address rows.
+func (r ApiSetDhcpScopeRequest) Exclusions(exclusions [][]string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "exclusions", formatTable(exclusions))
+	return r
+}
+
+// ReservedLeases sets the reservations as host name, hardware address, address and comments rows.
+func (r ApiSetDhcpScopeRequest) ReservedLeases(reservedLeases [][]string) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "reservedLeases", formatTable(reservedLeases))
+	return r
+}
+
+// AllowOnlyReservedLeases stops the scope from leasing to unknown clients.
+func (r ApiSetDhcpScopeRequest) AllowOnlyReservedLeases(allowOnlyReservedLeases bool) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "allowOnlyReservedLeases", formatBool(allowOnlyReservedLeases))
+	return r
+}
+
+// BlockLocallyAdministeredMacAddresses refuses clients with randomized MAC addresses.
+func (r ApiSetDhcpScopeRequest) BlockLocallyAdministeredMacAddresses(blockLocallyAdministeredMacAddresses bool) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "blockLocallyAdministeredMacAddresses", formatBool(blockLocallyAdministeredMacAddresses))
+	return r
+}
+
+// IgnoreClientIdentifierOption identifies clients by hardware address only.
+func (r ApiSetDhcpScopeRequest) IgnoreClientIdentifierOption(ignoreClientIdentifierOption bool) ApiSetDhcpScopeRequest {
+	r.query = withParam(r.query, "ignoreClientIdentifierOption", formatBool(ignoreClientIdentifierOption))
+	return r
+}
+
+func (r ApiSetDhcpScopeRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.SetDhcpScopeExecute(r)
+}
+
+// SetDhcpScope creates a scope or modifies an existing one. Options that are
+// not set on the request are left unchanged.
+func (a *DhcpAPIService) SetDhcpScope(ctx context.Context) ApiSetDhcpScopeRequest {
+	return ApiSetDhcpScopeRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// SetDhcpScopeExecute executes the request.
+func (a *DhcpAPIService) SetDhcpScopeExecute(r ApiSetDhcpScopeRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	return invokeForm[StatusResponse](r.ctx, a.client, "/api/dhcp/scopes/set", r.query)
+}
+
+type ApiEnableDhcpScopeRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+	query      url.Values
+}
+
+// Name sets the name of the scope.
+func (r ApiEnableDhcpScopeRequest) Name(name string) ApiEnableDhcpScopeRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+func (r ApiEnableDhcpScopeRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.EnableDhcpScopeExecute(r)
+}
+
+// EnableDhcpScope starts serving a scope.
+func (a *DhcpAPIService) EnableDhcpScope(ctx context.Context) ApiEnableDhcpScopeRequest {
+	return ApiEnableDhcpScopeRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// EnableDhcpScopeExecute executes the request.
+func (a *DhcpAPIService) EnableDhcpScopeExecute(r ApiEnableDhcpScopeRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/dhcp/scopes/enable", r.query)
+}
+
+type ApiDisableDhcpScopeRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+	query      url.Values
+}
+
+// Name sets the name of the scope.
+func (r ApiDisableDhcpScopeRequest) Name(name string) ApiDisableDhcpScopeRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+func (r ApiDisableDhcpScopeRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DisableDhcpScopeExecute(r)
+}
+
+// DisableDhcpScope stops serving a scope.
+func (a *DhcpAPIService) DisableDhcpScope(ctx context.Context) ApiDisableDhcpScopeRequest {
+	return ApiDisableDhcpScopeRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DisableDhcpScopeExecute executes the request.
+func (a *DhcpAPIService) DisableDhcpScopeExecute(r ApiDisableDhcpScopeRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/dhcp/scopes/disable", r.query)
+}
+
+type ApiDeleteDhcpScopeRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+	query      url.Values
+}
+
+// Name sets the name of the scope.
+func (r ApiDeleteDhcpScopeRequest) Name(name string) ApiDeleteDhcpScopeRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+func (r ApiDeleteDhcpScopeRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DeleteDhcpScopeExecute(r)
+}
+
+// DeleteDhcpScope deletes a scope.
+func (a *DhcpAPIService) DeleteDhcpScope(ctx context.Context) ApiDeleteDhcpScopeRequest {
+	return ApiDeleteDhcpScopeRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DeleteDhcpScopeExecute executes the request.
+func (a *DhcpAPIService) DeleteDhcpScopeExecute(r ApiDeleteDhcpScopeRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/dhcp/scopes/delete", r.query)
+}
+
+type ApiAddReservedLeaseRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+	query      url.Values
+}
+
+// Name sets the name of the scope.
+func (r ApiAddReservedLeaseRequest) Name(name string) ApiAddReservedLeaseRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// HardwareAddress sets the MAC address to reserve for.
+func (r ApiAddReservedLeaseRequest) HardwareAddress(hardwareAddress string) ApiAddReservedLeaseRequest {
+	r.query = withParam(r.query, "hardwareAddress", hardwareAddress)
+	return r
+}
+
+// IpAddress sets the reserved address.
+func (r ApiAddReservedLeaseRequest) IpAddress(ipAddress string) ApiAddReservedLeaseRequest {
+	r.query = withParam(r.query, "ipAddress", ipAddress)
+	return r
+}
+
+// HostName sets the host name of the reservation.
+func (r ApiAddReservedLeaseRequest) HostName(hostName string) ApiAddReservedLeaseRequest {
+	r.query = withParam(r.query, "hostName", hostName)
+	return r
+}
+
+// Comments sets the comments of the reservation.
+func (r ApiAddReservedLeaseRequest) Comments(comments string) ApiAddReservedLeaseRequest {
+	r.query = withParam(r.query, "comments", comments)
+	return r
+}
+
+func (r ApiAddReservedLeaseRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.AddReservedLeaseExecute(r)
+}
+
+// AddReservedLease reserves an address of a scope for a hardware address.
+func (a *DhcpAPIService) AddReservedLease(ctx context.Context) ApiAddReservedLeaseRequest {
+	return ApiAddReservedLeaseRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// AddReservedLeaseExecute executes the request.
+func (a *DhcpAPIService) AddReservedLeaseExecute(r ApiAddReservedLeaseRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "hardwareAddress"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "ipAddress"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/dhcp/scopes/addReservedLease", r.query)
+}
+
+type ApiRemoveReservedLeaseRequest struct {
+	ctx        context.Context
+	ApiService *DhcpAPIService
+	query      url.Values
+}
+
+// Name sets the name of the scope.
+func (r ApiRemoveReservedLeaseRequest) Name(name string) ApiRemoveReservedLeaseRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// HardwareAddress sets the MAC address of the reservation.
+func (r ApiRemoveReservedLeaseRequest) HardwareAddress(hardwareAddress string) ApiRemoveReservedLeaseRequest {
+	r.query = withParam(r.query, "hardwareAddress", hardwareAddress)
+	return r
+}
+
+func (r ApiRemoveReservedLeaseRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.RemoveReservedLeaseExecute(r)
+}
+
+// RemoveReservedLease removes a reservation from a scope.
+func (a *DhcpAPIService) RemoveReservedLease(ctx context.Context) ApiRemoveReservedLeaseRequest {
+	return ApiRemoveReservedLeaseRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// RemoveReservedLeaseExecute executes the request.
+func (a *DhcpAPIService) RemoveReservedLeaseExecute(r ApiRemoveReservedLeaseRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "hardwareAddress"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/dhcp/scopes/removeReservedLease", r.query)
+}
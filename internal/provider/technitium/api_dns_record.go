@@ -0,0 +1,532 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// DnsRecordAPIService manages the records of zones.
+type DnsRecordAPIService service
+
+type ApiCreateDnsRecordRequest struct {
+	ctx        context.Context
+	ApiService *DnsRecordAPIService
+	query      url.Values
+}
+
+// Zone sets the zone the record belongs to.
+func (r ApiCreateDnsRecordRequest) Zone(zone string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+// Domain sets the owner name of the record.
+func (r ApiCreateDnsRecordRequest) Domain(domain string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+// Type_ sets the record type.
+func (r ApiCreateDnsRecordRequest) Type_(type_ string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "type", type_)
+	return r
+}
+
+// Ttl sets the record TTL in seconds.
+func (r ApiCreateDnsRecordRequest) Ttl(ttl int32) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "ttl", formatInt(int64(ttl)))
+	return r
+}
+
+// Comments sets the comments stored with the record.
+func (r ApiCreateDnsRecordRequest) Comments(comments string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "comments", comments)
+	return r
+}
+
+// IpAddress sets the address of A and AAAA records.
+func (r ApiCreateDnsRecordRequest) IpAddress(ipAddress string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "ipAddress", ipAddress)
+	return r
+}
+
+// Ptr adds or updates the matching PTR record of A and AAAA records.
+func (r ApiCreateDnsRecordRequest) Ptr(ptr bool) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "ptr", formatBool(ptr))
+	return r
+}
+
+// CreatePtrZone creates the reverse zone for the PTR record if missing.
+func (r ApiCreateDnsRecordRequest) CreatePtrZone(createPtrZone bool) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "createPtrZone", formatBool(createPtrZone))
+	return r
+}
+
+// NameServer sets the name server of NS records.
+func (r ApiCreateDnsRecordRequest) NameServer(nameServer string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "nameServer", nameServer)
+	return r
+}
+
+// Glue sets the glue addresses of NS records.
+func (r ApiCreateDnsRecordRequest) Glue(glue string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "glue", glue)
+	return r
+}
+
+// Cname sets the target of CNAME records.
+func (r ApiCreateDnsRecordRequest) Cname(cname string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "cname", cname)
+	return r
+}
+
+// PtrName sets the target of PTR records.
+func (r ApiCreateDnsRecordRequest) PtrName(ptrName string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "ptrName", ptrName)
+	return r
+}
+
+// Exchange sets the mail exchange of MX records.
+func (r ApiCreateDnsRecordRequest) Exchange(exchange string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "exchange", exchange)
+	return r
+}
+
+// Preference sets the preference of MX records.
+func (r ApiCreateDnsRecordRequest) Preference(preference int32) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "preference", formatInt(int64(preference)))
+	return r
+}
+
+// Text sets the data of TXT records.
+func (r ApiCreateDnsRecordRequest) Text(text string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "text", text)
+	return r
+}
+
+// Priority sets the priority of SRV records.
+func (r ApiCreateDnsRecordRequest) Priority(priority int32) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "priority", formatInt(int64(priority)))
+	return r
+}
+
+// Weight sets the weight of SRV records.
+func (r ApiCreateDnsRecordRequest) Weight(weight int32) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "weight", formatInt(int64(weight)))
+	return r
+}
+
+// Port sets the port of SRV records.
+func (r ApiCreateDnsRecordRequest) Port(port int32) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "port", formatInt(int64(port)))
+	return r
+}
+
+// Target sets the target of SRV records.
+func (r ApiCreateDnsRecordRequest) Target(target string) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "target", target)
+	return r
+}
+
+// Overwrite replaces existing records of the same type instead of adding to them.
+func (r ApiCreateDnsRecordRequest) Overwrite(overwrite bool) ApiCreateDnsRecordRequest {
+	r.query = withParam(r.query, "overwrite", formatBool(overwrite))
+	return r
+}
+
+func (r ApiCreateDnsRecordRequest) Execute() (*DnsRecordResponse, *http.Response, error) {
+	return r.ApiService.CreateDnsRecordExecute(r)
+}
+
+// CreateDnsRecord adds a record to a zone.
+func (a *DnsRecordAPIService) CreateDnsRecord(ctx context.Context) ApiCreateDnsRecordRequest {
+	return ApiCreateDnsRecordRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// CreateDnsRecordExecute executes the request.
+func (a *DnsRecordAPIService) CreateDnsRecordExecute(r ApiCreateDnsRecordRequest) (*DnsRecordResponse, *http.Response, error) {
+	if err := requireParam(r.query, "domain"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "type"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[DnsRecordResponse](r.ctx, a.client, http.MethodGet, "/api/zones/records/add", r.query)
+}
+
+type ApiGetDnsRecordsRequest struct {
+	ctx        context.Context
+	ApiService *DnsRecordAPIService
+	query      url.Values
+}
+
+// Zone sets the zone to read from.
+func (r ApiGetDnsRecordsRequest) Zone(zone string) ApiGetDnsRecordsRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+// Domain sets the owner name to read.
+func (r ApiGetDnsRecordsRequest) Domain(domain string) ApiGetDnsRecordsRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+// ListZone returns every record of the zone instead of only the domain.
+func (r ApiGetDnsRecordsRequest) ListZone(listZone bool) ApiGetDnsRecordsRequest {
+	r.query = withParam(r.query, "listZone", formatBool(listZone))
+	return r
+}
+
+func (r ApiGetDnsRecordsRequest) Execute() (*GetDnsRecordsResponse, *http.Response, error) {
+	return r.ApiService.GetDnsRecordsExecute(r)
+}
+
+// GetDnsRecords returns the records of a domain, or of a whole zone.
+func (a *DnsRecordAPIService) GetDnsRecords(ctx context.Context) ApiGetDnsRecordsRequest {
+	return ApiGetDnsRecordsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// GetDnsRecordsExecute executes the request.
+func (a *DnsRecordAPIService) GetDnsRecordsExecute(r ApiGetDnsRecordsRequest) (*GetDnsRecordsResponse, *http.Response, error) {
+	if err := requireParam(r.query, "domain"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[GetDnsRecordsResponse](r.ctx, a.client, http.MethodGet, "/api/zones/records/get", r.query)
+}
+
+type ApiUpdateDnsRecordRequest struct {
+	ctx        context.Context
+	ApiService *DnsRecordAPIService
+	query      url.Values
+}
+
+// Zone sets the zone the record belongs to.
+func (r ApiUpdateDnsRecordRequest) Zone(zone string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+// Domain sets the owner name of the record.
+func (r ApiUpdateDnsRecordRequest) Domain(domain string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+// Type_ sets the record type.
+func (r ApiUpdateDnsRecordRequest) Type_(type_ string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "type", type_)
+	return r
+}
+
+// Ttl sets the record TTL in seconds.
+func (r ApiUpdateDnsRecordRequest) Ttl(ttl int32) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "ttl", formatInt(int64(ttl)))
+	return r
+}
+
+// Comments sets the comments stored with the record.
+func (r ApiUpdateDnsRecordRequest) Comments(comments string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "comments", comments)
+	return r
+}
+
+// IpAddress sets the address of A and AAAA records.
+func (r ApiUpdateDnsRecordRequest) IpAddress(ipAddress string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "ipAddress", ipAddress)
+	return r
+}
+
+// Ptr adds or updates the matching PTR record of A and AAAA records.
+func (r ApiUpdateDnsRecordRequest) Ptr(ptr bool) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "ptr", formatBool(ptr))
+	return r
+}
+
+// CreatePtrZone creates the reverse zone for the PTR record if missing.
+func (r ApiUpdateDnsRecordRequest) CreatePtrZone(createPtrZone bool) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "createPtrZone", formatBool(createPtrZone))
+	return r
+}
+
+// NameServer sets the name server of NS records.
+func (r ApiUpdateDnsRecordRequest) NameServer(nameServer string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "nameServer", nameServer)
+	return r
+}
+
+// Glue sets the glue addresses of NS records.
+func (r ApiUpdateDnsRecordRequest) Glue(glue string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "glue", glue)
+	return r
+}
+
+// Cname sets the target of CNAME records.
+func (r ApiUpdateDnsRecordRequest) Cname(cname string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "cname", cname)
+	return r
+}
+
+// PtrName sets the target of PTR records.
+func (r ApiUpdateDnsRecordRequest) PtrName(ptrName string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "ptrName", ptrName)
+	return r
+}
+
+// Exchange sets the mail exchange of MX records.
+func (r ApiUpdateDnsRecordRequest) Exchange(exchange string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "exchange", exchange)
+	return r
+}
+
+// Preference sets the preference of MX records.
+func (r ApiUpdateDnsRecordRequest) Preference(preference int32) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "preference", formatInt(int64(preference)))
+	return r
+}
+
+// Text sets the data of TXT records.
+func (r ApiUpdateDnsRecordRequest) Text(text string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "text", text)
+	return r
+}
+
+// Priority sets the priority of SRV records.
+func (r ApiUpdateDnsRecordRequest) Priority(priority int32) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "priority", formatInt(int64(priority)))
+	return r
+}
+
+// Weight sets the weight of SRV records.
+func (r ApiUpdateDnsRecordRequest) Weight(weight int32) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "weight", formatInt(int64(weight)))
+	return r
+}
+
+// Port sets the port of SRV records.
+func (r ApiUpdateDnsRecordRequest) Port(port int32) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "port", formatInt(int64(port)))
+	return r
+}
+
+// Target sets the target of SRV records.
+func (r ApiUpdateDnsRecordRequest) Target(target string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "target", target)
+	return r
+}
+
+// NewDomain renames the record.
+func (r ApiUpdateDnsRecordRequest) NewDomain(newDomain string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newDomain", newDomain)
+	return r
+}
+
+// Disable disables or enables the record.
+func (r ApiUpdateDnsRecordRequest) Disable(disable bool) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "disable", formatBool(disable))
+	return r
+}
+
+// NewIpAddress changes the address of A and AAAA records.
+func (r ApiUpdateDnsRecordRequest) NewIpAddress(newIpAddress string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newIpAddress", newIpAddress)
+	return r
+}
+
+// NewNameServer changes the name server of NS records.
+func (r ApiUpdateDnsRecordRequest) NewNameServer(newNameServer string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newNameServer", newNameServer)
+	return r
+}
+
+// NewPtrName changes the target of PTR records.
+func (r ApiUpdateDnsRecordRequest) NewPtrName(newPtrName string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newPtrName", newPtrName)
+	return r
+}
+
+// NewExchange changes the mail exchange of MX records.
+func (r ApiUpdateDnsRecordRequest) NewExchange(newExchange string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newExchange", newExchange)
+	return r
+}
+
+// NewPreference changes the preference of MX records.
+func (r ApiUpdateDnsRecordRequest) NewPreference(newPreference int32) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newPreference", formatInt(int64(newPreference)))
+	return r
+}
+
+// NewText changes the data of TXT records.
+func (r ApiUpdateDnsRecordRequest) NewText(newText string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newText", newText)
+	return r
+}
+
+// NewPriority changes the priority of SRV records.
+func (r ApiUpdateDnsRecordRequest) NewPriority(newPriority int32) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newPriority", formatInt(int64(newPriority)))
+	return r
+}
+
+// NewWeight changes the weight of SRV records.
+func (r ApiUpdateDnsRecordRequest) NewWeight(newWeight int32) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newWeight", formatInt(int64(newWeight)))
+	return r
+}
+
+// NewPort changes the port of SRV records.
+func (r ApiUpdateDnsRecordRequest) NewPort(newPort int32) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newPort", formatInt(int64(newPort)))
+	return r
+}
+
+// NewTarget changes the target of SRV records.
+func (r ApiUpdateDnsRecordRequest) NewTarget(newTarget string) ApiUpdateDnsRecordRequest {
+	r.query = withParam(r.query, "newTarget", newTarget)
+	return r
+}
+
+func (r ApiUpdateDnsRecordRequest) Execute() (*DnsRecordResponse, *http.Response, error) {
+	return r.ApiService.UpdateDnsRecordExecute(r)
+}
+
+// UpdateDnsRecord modifies a record. The current record data selects the
+// record to change and the New* parameters carry the new values.
+func (a *DnsRecordAPIService) UpdateDnsRecord(ctx context.Context) ApiUpdateDnsRecordRequest {
+	return ApiUpdateDnsRecordRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// UpdateDnsRecordExecute executes the request.
+func (a *DnsRecordAPIService) UpdateDnsRecordExecute(r ApiUpdateDnsRecordRequest) (*DnsRecordResponse, *http.Response, error) {
+	if err := requireParam(r.query, "domain"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "type"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[DnsRecordResponse](r.ctx, a.client, http.MethodGet, "/api/zones/records/update", r.query)
+}
+
+type ApiDeleteDnsRecordRequest struct {
+	ctx        context.Context
+	ApiService *DnsRecordAPIService
+	query      url.Values
+}
+
+// Zone sets the zone the record belongs to.
+func (r ApiDeleteDnsRecordRequest) Zone(zone string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+// Domain sets the owner name of the record.
+func (r ApiDeleteDnsRecordRequest) Domain(domain string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "domain", domain)
+	return r
+}
+
+// Type_ sets the record type.
+func (r ApiDeleteDnsRecordRequest) Type_(type_ string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "type", type_)
+	return r
+}
+
+// IpAddress sets the address of A and AAAA records.
+func (r ApiDeleteDnsRecordRequest) IpAddress(ipAddress string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "ipAddress", ipAddress)
+	return r
+}
+
+// NameServer sets the name server of NS records.
+func (r ApiDeleteDnsRecordRequest) NameServer(nameServer string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "nameServer", nameServer)
+	return r
+}
+
+// Cname sets the target of CNAME records.
+func (r ApiDeleteDnsRecordRequest) Cname(cname string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "cname", cname)
+	return r
+}
+
+// PtrName sets the target of PTR records.
+func (r ApiDeleteDnsRecordRequest) PtrName(ptrName string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "ptrName", ptrName)
+	return r
+}
+
+// Exchange sets the mail exchange of MX records.
+func (r ApiDeleteDnsRecordRequest) Exchange(exchange string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "exchange", exchange)
+	return r
+}
+
+// Preference sets the preference of MX records.
+func (r ApiDeleteDnsRecordRequest) Preference(preference int32) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "preference", formatInt(int64(preference)))
+	return r
+}
+
+// Text sets the data of TXT records.
+func (r ApiDeleteDnsRecordRequest) Text(text string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "text", text)
+	return r
+}
+
+// Priority sets the priority of SRV records.
+func (r ApiDeleteDnsRecordRequest) Priority(priority int32) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "priority", formatInt(int64(priority)))
+	return r
+}
+
+// Weight sets the weight of SRV records.
+func (r ApiDeleteDnsRecordRequest) Weight(weight int32) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "weight", formatInt(int64(weight)))
+	return r
+}
+
+// Port sets the port of SRV records.
+func (r ApiDeleteDnsRecordRequest) Port(port int32) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "port", formatInt(int64(port)))
+	return r
+}
+
+// Target sets the target of SRV records.
+func (r ApiDeleteDnsRecordRequest) Target(target string) ApiDeleteDnsRecordRequest {
+	r.query = withParam(r.query, "target", target)
+	return r
+}
+
+func (r ApiDeleteDnsRecordRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DeleteDnsRecordExecute(r)
+}
+
+// DeleteDnsRecord deletes the record matching the given data.
+func (a *DnsRecordAPIService) DeleteDnsRecord(ctx context.Context) ApiDeleteDnsRecordRequest {
+	return ApiDeleteDnsRecordRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DeleteDnsRecordExecute executes the request.
+func (a *DnsRecordAPIService) DeleteDnsRecordExecute(r ApiDeleteDnsRecordRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "domain"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "type"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/zones/records/delete", r.query)
+}
@@ -0,0 +1,343 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// DnsZoneAPIService manages authoritative zones.
+type DnsZoneAPIService service
+
+type ApiListDnsZonesRequest struct {
+	ctx        context.Context
+	ApiService *DnsZoneAPIService
+	query      url.Values
+}
+
+// PageNumber selects the page to return when paging is used.
+func (r ApiListDnsZonesRequest) PageNumber(pageNumber int32) ApiListDnsZonesRequest {
+	r.query = withParam(r.query, "pageNumber", formatInt(int64(pageNumber)))
+	return r
+}
+
+// ZonesPerPage sets the page size; without it all zones are returned.
+func (r ApiListDnsZonesRequest) ZonesPerPage(zonesPerPage int32) ApiListDnsZonesRequest {
+	r.query = withParam(r.query, "zonesPerPage", formatInt(int64(zonesPerPage)))
+	return r
+}
+
+func (r ApiListDnsZonesRequest) Execute() (*ListDnsZonesResponse, *http.Response, error) {
+	return r.ApiService.ListDnsZonesExecute(r)
+}
+
+// ListDnsZones lists the zones hosted by the server.
+func (a *DnsZoneAPIService) ListDnsZones(ctx context.Context) ApiListDnsZonesRequest {
+	return ApiListDnsZonesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ListDnsZonesExecute executes the request.
+func (a *DnsZoneAPIService) ListDnsZonesExecute(r ApiListDnsZonesRequest) (*ListDnsZonesResponse, *http.Response, error) {
+	return invoke[ListDnsZonesResponse](r.ctx, a.client, http.MethodGet, "/api/zones/list", r.query)
+}
+
+type ApiCreateDnsZoneRequest struct {
+	ctx        context.Context
+	ApiService *DnsZoneAPIService
+	query      url.Values
+}
+
+// Zone sets the domain name of the zone to create.
+func (r ApiCreateDnsZoneRequest) Zone(zone string) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+// Type_ sets the zone type: Primary, Secondary, Stub, Forwarder,
+// SecondaryForwarder, Catalog or SecondaryCatalog.
+func (r ApiCreateDnsZoneRequest) Type_(type_ string) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "type", type_)
+	return r
+}
+
+// Catalog sets the catalog zone the new zone is a member of.
+func (r ApiCreateDnsZoneRequest) Catalog(catalog string) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "catalog", catalog)
+	return r
+}
+
+// UseSoaSerialDateScheme enables the YYYYMMDDNN serial scheme for primary zones.
+func (r ApiCreateDnsZoneRequest) UseSoaSerialDateScheme(useSoaSerialDateScheme bool) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "useSoaSerialDateScheme", formatBool(useSoaSerialDateScheme))
+	return r
+}
+
+// PrimaryNameServerAddresses sets the primary servers of secondary and stub zones.
+func (r ApiCreateDnsZoneRequest) PrimaryNameServerAddresses(primaryNameServerAddresses []string) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "primaryNameServerAddresses", formatList(primaryNameServerAddresses))
+	return r
+}
+
+// ZoneTransferProtocol sets the transfer protocol of secondary zones: Tcp, Tls or Quic.
+func (r ApiCreateDnsZoneRequest) ZoneTransferProtocol(zoneTransferProtocol string) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "zoneTransferProtocol", zoneTransferProtocol)
+	return r
+}
+
+// TsigKeyName sets the TSIG key used for zone transfers.
+func (r ApiCreateDnsZoneRequest) TsigKeyName(tsigKeyName string) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "tsigKeyName", tsigKeyName)
+	return r
+}
+
+// Protocol sets the forwarder protocol of forwarder zones.
+func (r ApiCreateDnsZoneRequest) Protocol(protocol string) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "protocol", protocol)
+	return r
+}
+
+// Forwarder sets the forwarder address of forwarder zones.
+func (r ApiCreateDnsZoneRequest) Forwarder(forwarder string) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "forwarder", forwarder)
+	return r
+}
+
+// DnssecValidation enables DNSSEC validation of forwarder zones.
+func (r ApiCreateDnsZoneRequest) DnssecValidation(dnssecValidation bool) ApiCreateDnsZoneRequest {
+	r.query = withParam(r.query, "dnssecValidation", formatBool(dnssecValidation))
+	return r
+}
+
+func (r ApiCreateDnsZoneRequest) Execute() (*CreateDnsZoneResponse, *http.Response, error) {
+	return r.ApiService.CreateDnsZoneExecute(r)
+}
+
+// CreateDnsZone creates a zone.
+func (a *DnsZoneAPIService) CreateDnsZone(ctx context.Context) ApiCreateDnsZoneRequest {
+	return ApiCreateDnsZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// CreateDnsZoneExecute executes the request.
+func (a *DnsZoneAPIService) CreateDnsZoneExecute(r ApiCreateDnsZoneRequest) (*CreateDnsZoneResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "type"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[CreateDnsZoneResponse](r.ctx, a.client, http.MethodGet, "/api/zones/create", r.query)
+}
+
+type ApiDeleteDnsZoneRequest struct {
+	ctx        context.Context
+	ApiService *DnsZoneAPIService
+	query      url.Values
+}
+
+// Zone sets the domain name of the zone to delete.
+func (r ApiDeleteDnsZoneRequest) Zone(zone string) ApiDeleteDnsZoneRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+func (r ApiDeleteDnsZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DeleteDnsZoneExecute(r)
+}
+
+// DeleteDnsZone deletes a zone and all of its records.
+func (a *DnsZoneAPIService) DeleteDnsZone(ctx context.Context) ApiDeleteDnsZoneRequest {
+	return ApiDeleteDnsZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DeleteDnsZoneExecute executes the request.
+func (a *DnsZoneAPIService) DeleteDnsZoneExecute(r ApiDeleteDnsZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/zones/delete", r.query)
+}
+
+type ApiEnableDnsZoneRequest struct {
+	ctx        context.Context
+	ApiService *DnsZoneAPIService
+	query      url.Values
+}
+
+// Zone sets the domain name of the zone to enable.
+func (r ApiEnableDnsZoneRequest) Zone(zone string) ApiEnableDnsZoneRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+func (r ApiEnableDnsZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.EnableDnsZoneExecute(r)
+}
+
+// EnableDnsZone enables a disabled zone.
+func (a *DnsZoneAPIService) EnableDnsZone(ctx context.Context) ApiEnableDnsZoneRequest {
+	return ApiEnableDnsZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// EnableDnsZoneExecute executes the request.
+func (a *DnsZoneAPIService) EnableDnsZoneExecute(r ApiEnableDnsZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/zones/enable", r.query)
+}
+
+type ApiDisableDnsZoneRequest struct {
+	ctx        context.Context
+	ApiService *DnsZoneAPIService
+	query      url.Values
+}
+
+// Zone sets the domain name of the zone to disable.
+func (r ApiDisableDnsZoneRequest) Zone(zone string) ApiDisableDnsZoneRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+func (r ApiDisableDnsZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DisableDnsZoneExecute(r)
+}
+
+// DisableDnsZone stops the server from answering for a zone without deleting it.
+func (a *DnsZoneAPIService) DisableDnsZone(ctx context.Context) ApiDisableDnsZoneRequest {
+	return ApiDisableDnsZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DisableDnsZoneExecute executes the request.
+func (a *DnsZoneAPIService) DisableDnsZoneExecute(r ApiDisableDnsZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/zones/disable", r.query)
+}
+
+type ApiGetDnsZoneOptionsRequest struct {
+	ctx        context.Context
+	ApiService *DnsZoneAPIService
+	query      url.Values
+}
+
+// Zone sets the domain name of the zone to read.
+func (r ApiGetDnsZoneOptionsRequest) Zone(zone string) ApiGetDnsZoneOptionsRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+func (r ApiGetDnsZoneOptionsRequest) Execute() (*DnsZoneOptionsResponse, *http.Response, error) {
+	return r.ApiService.GetDnsZoneOptionsExecute(r)
+}
+
+// GetDnsZoneOptions returns the options of a zone.
+func (a *DnsZoneAPIService) GetDnsZoneOptions(ctx context.Context) ApiGetDnsZoneOptionsRequest {
+	return ApiGetDnsZoneOptionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// GetDnsZoneOptionsExecute executes the request.
+func (a *DnsZoneAPIService) GetDnsZoneOptionsExecute(r ApiGetDnsZoneOptionsRequest) (*DnsZoneOptionsResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[DnsZoneOptionsResponse](r.ctx, a.client, http.MethodGet, "/api/zones/options/get", r.query)
+}
+
+type ApiSetDnsZoneOptionsRequest struct {
+	ctx        context.Context
+	ApiService *DnsZoneAPIService
+	query      url.Values
+}
+
+// Zone sets the domain name of the zone to modify.
+func (r ApiSetDnsZoneOptionsRequest) Zone(zone string) ApiSetDnsZoneOptionsRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+// Disabled enables or disables the zone.
+func (r ApiSetDnsZoneOptionsRequest) Disabled(disabled bool) ApiSetDnsZoneOptionsRequest {
+	r.query = withParam(r.query, "disabled", formatBool(disabled))
+	return r
+}
+
+// ZoneTransfer sets who may transfer the zone: Deny, Allow,
+// AllowOnlyZoneNameServers, UseSpecifiedNetworkACL or
+// AllowZoneNameServersAndUseSpecifiedNetworkACL.
+func (r ApiSetDnsZoneOptionsRequest) ZoneTransfer(zoneTransfer string) ApiSetDnsZoneOptionsRequest {
+	r.query = withParam(r.query, "zoneTransfer", zoneTransfer)
+	return r
+}
+
+// ZoneTransferNetworkACL sets the networks allowed to transfer the zone.
+func (r ApiSetDnsZoneOptionsRequest) ZoneTransferNetworkACL(zoneTransferNetworkACL []string) ApiSetDnsZoneOptionsRequest {
+	r.query = withParam(r.query, "zoneTransferNetworkACL", formatList(zoneTransferNetworkACL))
+	return r
+}
+
+// Notify sets which servers are notified of changes: None,
+// ZoneNameServers, SpecifiedNameServers or BothZoneAndSpecifiedNameServers.
+func (r ApiSetDnsZoneOptionsRequest) Notify(notify string) ApiSetDnsZoneOptionsRequest {
+	r.query = withParam(r.query, "notify", notify)
+	return r
+}
+
+// NotifyNameServers sets the servers notified of changes.
+func (r ApiSetDnsZoneOptionsRequest) NotifyNameServers(notifyNameServers []string) ApiSetDnsZoneOptionsRequest {
+	r.query = withParam(r.query, "notifyNameServers", formatList(notifyNameServers))
+	return r
+}
+
+// Update sets who may send dynamic updates: Deny, Allow,
+// AllowOnlyZoneNameServers, UseSpecifiedNetworkACL or
+// AllowZoneNameServersAndUseSpecifiedNetworkACL.
+func (r ApiSetDnsZoneOptionsRequest) Update(update string) ApiSetDnsZoneOptionsRequest {
+	r.query = withParam(r.query, "update", update)
+	return r
+}
+
+// UpdateNetworkACL sets the networks allowed to send dynamic updates.
+func (r ApiSetDnsZoneOptionsRequest) UpdateNetworkACL(updateNetworkACL []string) ApiSetDnsZoneOptionsRequest {
+	r.query = withParam(r.query, "updateNetworkACL", formatList(updateNetworkACL))
+	return r
+}
+
+func (r ApiSetDnsZoneOptionsRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.SetDnsZoneOptionsExecute(r)
+}
+
+// SetDnsZoneOptions modifies the options of a zone. Options that are not set
+// on the request are left unchanged.
+func (a *DnsZoneAPIService) SetDnsZoneOptions(ctx context.Context) ApiSetDnsZoneOptionsRequest {
+	return ApiSetDnsZoneOptionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// SetDnsZoneOptionsExecute executes the request.
+func (a *DnsZoneAPIService) SetDnsZoneOptionsExecute(r ApiSetDnsZoneOptionsRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/zones/options/set", r.query)
+}
@@ -0,0 +1,201 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// DnssecAPIService manages DNSSEC signing of zones.
+type DnssecAPIService service
+
+type ApiSignDnsZoneRequest struct {
+	ctx        context.Context
+	ApiService *DnssecAPIService
+	query      url.Values
+}
+
+// Zone sets the zone.
+func (r ApiSignDnsZoneRequest) Zone(zone string) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+// Algorithm sets the key algorithm: RSA, ECDSA or EDDSA.
+func (r ApiSignDnsZoneRequest) Algorithm(algorithm string) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "algorithm", algorithm)
+	return r
+}
+
+// HashAlgorithm sets the RSA hash algorithm: MD5, SHA1, SHA256 or SHA512.
+func (r ApiSignDnsZoneRequest) HashAlgorithm(hashAlgorithm string) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "hashAlgorithm", hashAlgorithm)
+	return r
+}
+
+// KskKeySize sets the RSA key signing key size in bits.
+func (r ApiSignDnsZoneRequest) KskKeySize(kskKeySize int32) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "kskKeySize", formatInt(int64(kskKeySize)))
+	return r
+}
+
+// ZskKeySize sets the RSA zone signing key size in bits.
+func (r ApiSignDnsZoneRequest) ZskKeySize(zskKeySize int32) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "zskKeySize", formatInt(int64(zskKeySize)))
+	return r
+}
+
+// Curve sets the ECDSA or EDDSA curve: P256, P384, ED25519 or ED448.
+func (r ApiSignDnsZoneRequest) Curve(curve string) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "curve", curve)
+	return r
+}
+
+// DnsKeyTtl sets the TTL of the DNSKEY records.
+func (r ApiSignDnsZoneRequest) DnsKeyTtl(dnsKeyTtl int32) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "dnsKeyTtl", formatInt(int64(dnsKeyTtl)))
+	return r
+}
+
+// ZskRolloverDays sets the automatic zone signing key rollover interval.
+func (r ApiSignDnsZoneRequest) ZskRolloverDays(zskRolloverDays int32) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "zskRolloverDays", formatInt(int64(zskRolloverDays)))
+	return r
+}
+
+// NxProof sets the denial of existence proof: NSEC or NSEC3.
+func (r ApiSignDnsZoneRequest) NxProof(nxProof string) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "nxProof", nxProof)
+	return r
+}
+
+// Iterations sets the NSEC3 iterations.
+func (r ApiSignDnsZoneRequest) Iterations(iterations int32) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "iterations", formatInt(int64(iterations)))
+	return r
+}
+
+// SaltLength sets the NSEC3 salt length.
+func (r ApiSignDnsZoneRequest) SaltLength(saltLength int32) ApiSignDnsZoneRequest {
+	r.query = withParam(r.query, "saltLength", formatInt(int64(saltLength)))
+	return r
+}
+
+func (r ApiSignDnsZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.SignDnsZoneExecute(r)
+}
+
+// SignDnsZone signs a primary zone with DNSSEC.
+func (a *DnssecAPIService) SignDnsZone(ctx context.Context) ApiSignDnsZoneRequest {
+	return ApiSignDnsZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// SignDnsZoneExecute executes the request.
+func (a *DnssecAPIService) SignDnsZoneExecute(r ApiSignDnsZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "algorithm"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/zones/dnssec/sign", r.query)
+}
+
+type ApiUnsignDnsZoneRequest struct {
+	ctx        context.Context
+	ApiService *DnssecAPIService
+	query      url.Values
+}
+
+// Zone sets the zone.
+func (r ApiUnsignDnsZoneRequest) Zone(zone string) ApiUnsignDnsZoneRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+func (r ApiUnsignDnsZoneRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.UnsignDnsZoneExecute(r)
+}
+
+// UnsignDnsZone removes DNSSEC signing from a zone.
+func (a *DnssecAPIService) UnsignDnsZone(ctx context.Context) ApiUnsignDnsZoneRequest {
+	return ApiUnsignDnsZoneRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// UnsignDnsZoneExecute executes the request.
+func (a *DnssecAPIService) UnsignDnsZoneExecute(r ApiUnsignDnsZoneRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/zones/dnssec/unsign", r.query)
+}
+
+type ApiGetDnssecPropertiesRequest struct {
+	ctx        context.Context
+	ApiService *DnssecAPIService
+	query      url.Values
+}
+
+// Zone sets the zone.
+func (r ApiGetDnssecPropertiesRequest) Zone(zone string) ApiGetDnssecPropertiesRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+func (r ApiGetDnssecPropertiesRequest) Execute() (*DnssecPropertiesResponse, *http.Response, error) {
+	return r.ApiService.GetDnssecPropertiesExecute(r)
+}
+
+// GetDnssecProperties returns the signing state and keys of a zone.
+func (a *DnssecAPIService) GetDnssecProperties(ctx context.Context) ApiGetDnssecPropertiesRequest {
+	return ApiGetDnssecPropertiesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// GetDnssecPropertiesExecute executes the request.
+func (a *DnssecAPIService) GetDnssecPropertiesExecute(r ApiGetDnssecPropertiesRequest) (*DnssecPropertiesResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[DnssecPropertiesResponse](r.ctx, a.client, http.MethodGet, "/api/zones/dnssec/properties/get", r.query)
+}
+
+type ApiViewDsInfoRequest struct {
+	ctx        context.Context
+	ApiService *DnssecAPIService
+	query      url.Values
+}
+
+// Zone sets the zone.
+func (r ApiViewDsInfoRequest) Zone(zone string) ApiViewDsInfoRequest {
+	r.query = withParam(r.query, "zone", zone)
+	return r
+}
+
+func (r ApiViewDsInfoRequest) Execute() (*DsInfoResponse, *http.Response, error) {
+	return r.ApiService.ViewDsInfoExecute(r)
+}
+
+// ViewDsInfo returns the DS records of a signed zone.
+func (a *DnssecAPIService) ViewDsInfo(ctx context.Context) ApiViewDsInfoRequest {
+	return ApiViewDsInfoRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ViewDsInfoExecute executes the request.
+func (a *DnssecAPIService) ViewDsInfoExecute(r ApiViewDsInfoRequest) (*DsInfoResponse, *http.Response, error) {
+	if err := requireParam(r.query, "zone"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[DsInfoResponse](r.ctx, a.client, http.MethodGet, "/api/zones/dnssec/viewDS", r.query)
+}
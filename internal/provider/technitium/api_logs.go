@@ -0,0 +1,211 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// LogsAPIService manages the server logs.
+type LogsAPIService service
+
+type ApiListLogsRequest struct {
+	ctx        context.Context
+	ApiService *LogsAPIService
+}
+
+func (r ApiListLogsRequest) Execute() (*ListLogsResponse, *http.Response, error) {
+	return r.ApiService.ListLogsExecute(r)
+}
+
+// ListLogs lists the log files of the server.
+func (a *LogsAPIService) ListLogs(ctx context.Context) ApiListLogsRequest {
+	return ApiListLogsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ListLogsExecute executes the request.
+func (a *LogsAPIService) ListLogsExecute(r ApiListLogsRequest) (*ListLogsResponse, *http.Response, error) {
+	return invoke[ListLogsResponse](r.ctx, a.client, http.MethodGet, "/api/logs/list", nil)
+}
+
+type ApiDeleteLogRequest struct {
+	ctx        context.Context
+	ApiService *LogsAPIService
+	query      url.Values
+}
+
+// Log sets the name of the log file.
+func (r ApiDeleteLogRequest) Log(log string) ApiDeleteLogRequest {
+	r.query = withParam(r.query, "log", log)
+	return r
+}
+
+func (r ApiDeleteLogRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.DeleteLogExecute(r)
+}
+
+// DeleteLog deletes a log file.
+func (a *LogsAPIService) DeleteLog(ctx context.Context) ApiDeleteLogRequest {
+	return ApiDeleteLogRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DeleteLogExecute executes the request.
+func (a *LogsAPIService) DeleteLogExecute(r ApiDeleteLogRequest) (*StatusResponse, *http.Response, error) {
+	if err := requireParam(r.query, "log"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/logs/delete", r.query)
+}
+
+type ApiQueryLogsRequest struct {
+	ctx        context.Context
+	ApiService *LogsAPIService
+	query      url.Values
+}
+
+// Name sets the name of the query logger app.
+func (r ApiQueryLogsRequest) Name(name string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "name", name)
+	return r
+}
+
+// ClassPath sets the class path of the query logger app.
+func (r ApiQueryLogsRequest) ClassPath(classPath string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "classPath", classPath)
+	return r
+}
+
+// PageNumber selects the page to return.
+func (r ApiQueryLogsRequest) PageNumber(pageNumber int32) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "pageNumber", formatInt(int64(pageNumber)))
+	return r
+}
+
+// EntriesPerPage sets the page size.
+func (r ApiQueryLogsRequest) EntriesPerPage(entriesPerPage int32) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "entriesPerPage", formatInt(int64(entriesPerPage)))
+	return r
+}
+
+// DescendingOrder returns the newest entries first.
+func (r ApiQueryLogsRequest) DescendingOrder(descendingOrder bool) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "descendingOrder", formatBool(descendingOrder))
+	return r
+}
+
+// Start filters out entries before this ISO 8601 time.
+func (r ApiQueryLogsRequest) Start(start string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "start", start)
+	return r
+}
+
+// End filters out entries after this ISO 8601 time.
+func (r ApiQueryLogsRequest) End(end string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "end", end)
+	return r
+}
+
+// ClientIpAddress filters entries by client address.
+func (r ApiQueryLogsRequest) ClientIpAddress(clientIpAddress string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "clientIpAddress", clientIpAddress)
+	return r
+}
+
+// Protocol filters entries by protocol.
+func (r ApiQueryLogsRequest) Protocol(protocol string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "protocol", protocol)
+	return r
+}
+
+// ResponseType filters entries by response type.
+func (r ApiQueryLogsRequest) ResponseType(responseType string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "responseType", responseType)
+	return r
+}
+
+// Rcode filters entries by response code.
+func (r ApiQueryLogsRequest) Rcode(rcode string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "rcode", rcode)
+	return r
+}
+
+// Qname filters entries by query name.
+func (r ApiQueryLogsRequest) Qname(qname string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "qname", qname)
+	return r
+}
+
+// Qtype filters entries by query type.
+func (r ApiQueryLogsRequest) Qtype(qtype string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "qtype", qtype)
+	return r
+}
+
+// Qclass filters entries by query class.
+func (r ApiQueryLogsRequest) Qclass(qclass string) ApiQueryLogsRequest {
+	r.query = withParam(r.query, "qclass", qclass)
+	return r
+}
+
+func (r ApiQueryLogsRequest) Execute() (*QueryLogsResponse, *http.Response, error) {
+	return r.ApiService.QueryLogsExecute(r)
+}
+
+// QueryLogs queries the entries stored by a query logger app.
+func (a *LogsAPIService) QueryLogs(ctx context.Context) ApiQueryLogsRequest {
+	return ApiQueryLogsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// QueryLogsExecute executes the request.
+func (a *LogsAPIService) QueryLogsExecute(r ApiQueryLogsRequest) (*QueryLogsResponse, *http.Response, error) {
+	if err := requireParam(r.query, "name"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "classPath"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[QueryLogsResponse](r.ctx, a.client, http.MethodGet, "/api/logs/query", r.query)
+}
+
+type ApiDownloadLogRequest struct {
+	ctx        context.Context
+	ApiService *LogsAPIService
+	query      url.Values
+}
+
+// FileName sets the name of the log file without extension.
+func (r ApiDownloadLogRequest) FileName(fileName string) ApiDownloadLogRequest {
+	r.query = withParam(r.query, "fileName", fileName)
+	return r
+}
+
+func (r ApiDownloadLogRequest) Execute() ([]byte, *http.Response, error) {
+	return r.ApiService.DownloadLogExecute(r)
+}
+
+// DownloadLog returns the content of a log file.
+func (a *LogsAPIService) DownloadLog(ctx context.Context) ApiDownloadLogRequest {
+	return ApiDownloadLogRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// DownloadLogExecute executes the request.
+func (a *LogsAPIService) DownloadLogExecute(r ApiDownloadLogRequest) ([]byte, *http.Response, error) {
+	if err := requireParam(r.query, "fileName"); err != nil {
+		return nil, nil, err
+	}
+	var out []byte
+	resp, err := a.client.callAPI(r.ctx, http.MethodGet, "/api/logs/download", r.query, nil, "", &out)
+	return out, resp, err
+}
@@ -0,0 +1,602 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// SettingsAPIService manages the server-wide settings.
+type SettingsAPIService service
+
+type ApiGetSettingsRequest struct {
+	ctx        context.Context
+	ApiService *SettingsAPIService
+}
+
+func (r ApiGetSettingsRequest) Execute() (*DnsSettingsResponse, *http.Response, error) {
+	return r.ApiService.GetSettingsExecute(r)
+}
+
+// GetSettings returns the server settings.
+func (a *SettingsAPIService) GetSettings(ctx context.Context) ApiGetSettingsRequest {
+	return ApiGetSettingsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// GetSettingsExecute executes the request.
+func (a *SettingsAPIService) GetSettingsExecute(r ApiGetSettingsRequest) (*DnsSettingsResponse, *http.Response, error) {
+	return invoke[DnsSettingsResponse](r.ctx, a.client, http.MethodGet, "/api/settings/get", nil)
+}
+
+type ApiSetSettingsRequest struct {
+	ctx        context.Context
+	ApiService *SettingsAPIService
+	query      url.Values
+}
+
+// DnsServerDomain sets the primary domain name of the server.
+func (r ApiSetSettingsRequest) DnsServerDomain(dnsServerDomain string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "dnsServerDomain", dnsServerDomain)
+	return r
+}
+
+// DnsServerLocalEndPoints sets the address:port pairs the DNS server listens on.
+func (r ApiSetSettingsRequest) DnsServerLocalEndPoints(dnsServerLocalEndPoints []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "dnsServerLocalEndPoints", formatList(dnsServerLocalEndPoints))
+	return r
+}
+
+// DnsServerIPv4SourceAddresses sets the IPv4 source addresses for outbound queries.
+func (r ApiSetSettingsRequest) DnsServerIPv4SourceAddresses(dnsServerIPv4SourceAddresses []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "dnsServerIPv4SourceAddresses", formatList(dnsServerIPv4SourceAddresses))
+	return r
+}
+
+// DnsServerIPv6SourceAddresses sets the IPv6 source addresses for outbound queries.
+func (r ApiSetSettingsRequest) DnsServerIPv6SourceAddresses(dnsServerIPv6SourceAddresses []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "dnsServerIPv6SourceAddresses", formatList(dnsServerIPv6SourceAddresses))
+	return r
+}
+
+// DefaultRecordTtl sets the TTL used for records added without one.
+func (r ApiSetSettingsRequest) DefaultRecordTtl(defaultRecordTtl int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "defaultRecordTtl", formatInt(int64(defaultRecordTtl)))
+	return r
+}
+
+// DefaultResponsiblePerson sets the SOA responsible person of new zones.
+func (r ApiSetSettingsRequest) DefaultResponsiblePerson(defaultResponsiblePerson string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "defaultResponsiblePerson", defaultResponsiblePerson)
+	return r
+}
+
+// UseSoaSerialDateScheme sets the default serial scheme of new zones.
+func (r ApiSetSettingsRequest) UseSoaSerialDateScheme(useSoaSerialDateScheme bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "useSoaSerialDateScheme", formatBool(useSoaSerialDateScheme))
+	return r
+}
+
+// MinSoaRefresh sets the minimum SOA refresh of secondary zones.
+func (r ApiSetSettingsRequest) MinSoaRefresh(minSoaRefresh int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "minSoaRefresh", formatInt(int64(minSoaRefresh)))
+	return r
+}
+
+// MinSoaRetry sets the minimum SOA retry of secondary zones.
+func (r ApiSetSettingsRequest) MinSoaRetry(minSoaRetry int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "minSoaRetry", formatInt(int64(minSoaRetry)))
+	return r
+}
+
+// ZoneTransferAllowedNetworks sets the networks allowed to transfer any zone.
+func (r ApiSetSettingsRequest) ZoneTransferAllowedNetworks(zoneTransferAllowedNetworks []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "zoneTransferAllowedNetworks", formatList(zoneTransferAllowedNetworks))
+	return r
+}
+
+// NotifyAllowedNetworks sets the networks allowed to send NOTIFY for any zone.
+func (r ApiSetSettingsRequest) NotifyAllowedNetworks(notifyAllowedNetworks []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "notifyAllowedNetworks", formatList(notifyAllowedNetworks))
+	return r
+}
+
+// DnsAppsEnableAutomaticUpdate enables automatic updates of installed apps.
+func (r ApiSetSettingsRequest) DnsAppsEnableAutomaticUpdate(dnsAppsEnableAutomaticUpdate bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "dnsAppsEnableAutomaticUpdate", formatBool(dnsAppsEnableAutomaticUpdate))
+	return r
+}
+
+// PreferIPv6 makes the resolver prefer IPv6 name servers.
+func (r ApiSetSettingsRequest) PreferIPv6(preferIPv6 bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "preferIPv6", formatBool(preferIPv6))
+	return r
+}
+
+// EnableUdpSocketPool enables the UDP socket pool for outbound queries.
+func (r ApiSetSettingsRequest) EnableUdpSocketPool(enableUdpSocketPool bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "enableUdpSocketPool", formatBool(enableUdpSocketPool))
+	return r
+}
+
+// UdpPayloadSize sets the EDNS UDP payload size.
+func (r ApiSetSettingsRequest) UdpPayloadSize(udpPayloadSize int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "udpPayloadSize", formatInt(int64(udpPayloadSize)))
+	return r
+}
+
+// DnssecValidation enables DNSSEC validation of recursive answers.
+func (r ApiSetSettingsRequest) DnssecValidation(dnssecValidation bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "dnssecValidation", formatBool(dnssecValidation))
+	return r
+}
+
+// EDnsClientSubnet enables EDNS Client Subnet for recursive queries.
+func (r ApiSetSettingsRequest) EDnsClientSubnet(eDnsClientSubnet bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "eDnsClientSubnet", formatBool(eDnsClientSubnet))
+	return r
+}
+
+// EDnsClientSubnetIPv4PrefixLength sets the IPv4 prefix length sent with EDNS Client Subnet.
+func (r ApiSetSettingsRequest) EDnsClientSubnetIPv4PrefixLength(eDnsClientSubnetIPv4PrefixLength int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "eDnsClientSubnetIPv4PrefixLength", formatInt(int64(eDnsClientSubnetIPv4PrefixLength)))
+	return r
+}
+
+// EDnsClientSubnetIPv6PrefixLength sets the IPv6 prefix length sent with EDNS Client Subnet.
+func (r ApiSetSettingsRequest) EDnsClientSubnetIPv6PrefixLength(eDnsClientSubnetIPv6PrefixLength int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "eDnsClientSubnetIPv6PrefixLength", formatInt(int64(eDnsClientSubnetIPv6PrefixLength)))
+	return r
+}
+
+// QpmLimitRequests sets the queries per minute allowed per client subnet.
+func (r ApiSetSettingsRequest) QpmLimitRequests(qpmLimitRequests int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "qpmLimitRequests", formatInt(int64(qpmLimitRequests)))
+	return r
+}
+
+// QpmLimitErrors sets the error responses per minute allowed per client subnet.
+func (r ApiSetSettingsRequest) QpmLimitErrors(qpmLimitErrors int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "qpmLimitErrors", formatInt(int64(qpmLimitErrors)))
+	return r
+}
+
+// QpmLimitSampleMinutes sets the sample window of the queries per minute limit.
+func (r ApiSetSettingsRequest) QpmLimitSampleMinutes(qpmLimitSampleMinutes int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "qpmLimitSampleMinutes", formatInt(int64(qpmLimitSampleMinutes)))
+	return r
+}
+
+// QpmLimitIPv4PrefixLength sets the IPv4 subnet size the limits apply to.
+func (r ApiSetSettingsRequest) QpmLimitIPv4PrefixLength(qpmLimitIPv4PrefixLength int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "qpmLimitIPv4PrefixLength", formatInt(int64(qpmLimitIPv4PrefixLength)))
+	return r
+}
+
+// QpmLimitIPv6PrefixLength sets the IPv6 subnet size the limits apply to.
+func (r ApiSetSettingsRequest) QpmLimitIPv6PrefixLength(qpmLimitIPv6PrefixLength int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "qpmLimitIPv6PrefixLength", formatInt(int64(qpmLimitIPv6PrefixLength)))
+	return r
+}
+
+// QpmLimitBypassList sets the networks exempt from the queries per minute limit.
+func (r ApiSetSettingsRequest) QpmLimitBypassList(qpmLimitBypassList []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "qpmLimitBypassList", formatList(qpmLimitBypassList))
+	return r
+}
+
+// ClientTimeout sets the time in milliseconds to wait before answering a client with ServerFailure.
+func (r ApiSetSettingsRequest) ClientTimeout(clientTimeout int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "clientTimeout", formatInt(int64(clientTimeout)))
+	return r
+}
+
+// TcpSendTimeout sets the TCP send timeout in milliseconds.
+func (r ApiSetSettingsRequest) TcpSendTimeout(tcpSendTimeout int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "tcpSendTimeout", formatInt(int64(tcpSendTimeout)))
+	return r
+}
+
+// TcpReceiveTimeout sets the TCP receive timeout in milliseconds.
+func (r ApiSetSettingsRequest) TcpReceiveTimeout(tcpReceiveTimeout int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "tcpReceiveTimeout", formatInt(int64(tcpReceiveTimeout)))
+	return r
+}
+
+// ListenBacklog sets the maximum pending inbound connections.
+func (r ApiSetSettingsRequest) ListenBacklog(listenBacklog int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "listenBacklog", formatInt(int64(listenBacklog)))
+	return r
+}
+
+// MaxConcurrentResolutionsPerCore sets the limit of concurrent outbound resolutions per CPU core.
+func (r ApiSetSettingsRequest) MaxConcurrentResolutionsPerCore(maxConcurrentResolutionsPerCore int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "maxConcurrentResolutionsPerCore", formatInt(int64(maxConcurrentResolutionsPerCore)))
+	return r
+}
+
+// WebServiceLocalAddresses sets the addresses the web service listens on.
+func (r ApiSetSettingsRequest) WebServiceLocalAddresses(webServiceLocalAddresses []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "webServiceLocalAddresses", formatList(webServiceLocalAddresses))
+	return r
+}
+
+// WebServiceHttpPort sets the HTTP port of the web service.
+func (r ApiSetSettingsRequest) WebServiceHttpPort(webServiceHttpPort int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "webServiceHttpPort", formatInt(int64(webServiceHttpPort)))
+	return r
+}
+
+// WebServiceEnableTls enables HTTPS for the web service.
+func (r ApiSetSettingsRequest) WebServiceEnableTls(webServiceEnableTls bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "webServiceEnableTls", formatBool(webServiceEnableTls))
+	return r
+}
+
+// WebServiceTlsPort sets the HTTPS port of the web service.
+func (r ApiSetSettingsRequest) WebServiceTlsPort(webServiceTlsPort int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "webServiceTlsPort", formatInt(int64(webServiceTlsPort)))
+	return r
+}
+
+// EnableDnsOverHttp enables the DNS-over-HTTP listener for reverse proxies.
+func (r ApiSetSettingsRequest) EnableDnsOverHttp(enableDnsOverHttp bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "enableDnsOverHttp", formatBool(enableDnsOverHttp))
+	return r
+}
+
+// EnableDnsOverTls enables the DNS-over-TLS listener.
+func (r ApiSetSettingsRequest) EnableDnsOverTls(enableDnsOverTls bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "enableDnsOverTls", formatBool(enableDnsOverTls))
+	return r
+}
+
+// EnableDnsOverHttps enables the DNS-over-HTTPS listener.
+func (r ApiSetSettingsRequest) EnableDnsOverHttps(enableDnsOverHttps bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "enableDnsOverHttps", formatBool(enableDnsOverHttps))
+	return r
+}
+
+// EnableDnsOverQuic enables the DNS-over-QUIC listener.
+func (r ApiSetSettingsRequest) EnableDnsOverQuic(enableDnsOverQuic bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "enableDnsOverQuic", formatBool(enableDnsOverQuic))
+	return r
+}
+
+// Recursion sets who may use recursion: Deny, Allow, AllowOnlyForPrivateNetworks or UseSpecifiedNetworkACL.
+func (r ApiSetSettingsRequest) Recursion(recursion string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "recursion", recursion)
+	return r
+}
+
+// RecursionNetworkACL sets the ordered recursion ACL. Entries prefixed with ! deny.
+func (r ApiSetSettingsRequest) RecursionNetworkACL(recursionNetworkACL []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "recursionNetworkACL", formatList(recursionNetworkACL))
+	return r
+}
+
+// RandomizeName enables QNAME case randomization.
+func (r ApiSetSettingsRequest) RandomizeName(randomizeName bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "randomizeName", formatBool(randomizeName))
+	return r
+}
+
+// QnameMinimization enables QNAME minimization.
+func (r ApiSetSettingsRequest) QnameMinimization(qnameMinimization bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "qnameMinimization", formatBool(qnameMinimization))
+	return r
+}
+
+// NsRevalidation enables NS revalidation of delegations.
+func (r ApiSetSettingsRequest) NsRevalidation(nsRevalidation bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "nsRevalidation", formatBool(nsRevalidation))
+	return r
+}
+
+// ResolverRetries sets the retries of the recursive resolver.
+func (r ApiSetSettingsRequest) ResolverRetries(resolverRetries int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "resolverRetries", formatInt(int64(resolverRetries)))
+	return r
+}
+
+// ResolverTimeout sets the timeout in milliseconds of the recursive resolver.
+func (r ApiSetSettingsRequest) ResolverTimeout(resolverTimeout int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "resolverTimeout", formatInt(int64(resolverTimeout)))
+	return r
+}
+
+// ResolverConcurrency sets the name servers queried concurrently by the recursive resolver.
+func (r ApiSetSettingsRequest) ResolverConcurrency(resolverConcurrency int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "resolverConcurrency", formatInt(int64(resolverConcurrency)))
+	return r
+}
+
+// ResolverMaxStackCount sets the maximum recursion depth of the resolver.
+func (r ApiSetSettingsRequest) ResolverMaxStackCount(resolverMaxStackCount int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "resolverMaxStackCount", formatInt(int64(resolverMaxStackCount)))
+	return r
+}
+
+// SaveCache persists the cache to disk on shutdown.
+func (r ApiSetSettingsRequest) SaveCache(saveCache bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "saveCache", formatBool(saveCache))
+	return r
+}
+
+// ServeStale enables serving stale answers when upstreams fail.
+func (r ApiSetSettingsRequest) ServeStale(serveStale bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "serveStale", formatBool(serveStale))
+	return r
+}
+
+// ServeStaleTtl sets how long in seconds expired records are kept for serving stale.
+func (r ApiSetSettingsRequest) ServeStaleTtl(serveStaleTtl int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "serveStaleTtl", formatInt(int64(serveStaleTtl)))
+	return r
+}
+
+// ServeStaleAnswerTtl sets the TTL of stale answers.
+func (r ApiSetSettingsRequest) ServeStaleAnswerTtl(serveStaleAnswerTtl int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "serveStaleAnswerTtl", formatInt(int64(serveStaleAnswerTtl)))
+	return r
+}
+
+// ServeStaleResetTtl sets the TTL a stale record is reset to when refreshing fails.
+func (r ApiSetSettingsRequest) ServeStaleResetTtl(serveStaleResetTtl int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "serveStaleResetTtl", formatInt(int64(serveStaleResetTtl)))
+	return r
+}
+
+// ServeStaleMaxWaitTime sets the time in milliseconds to wait for a fresh answer before serving stale.
+func (r ApiSetSettingsRequest) ServeStaleMaxWaitTime(serveStaleMaxWaitTime int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "serveStaleMaxWaitTime", formatInt(int64(serveStaleMaxWaitTime)))
+	return r
+}
+
+// CacheMaximumEntries sets the maximum number of cache entries.
+func (r ApiSetSettingsRequest) CacheMaximumEntries(cacheMaximumEntries int64) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "cacheMaximumEntries", formatInt(cacheMaximumEntries))
+	return r
+}
+
+// CacheMinimumRecordTtl sets the minimum TTL of cached records.
+func (r ApiSetSettingsRequest) CacheMinimumRecordTtl(cacheMinimumRecordTtl int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "cacheMinimumRecordTtl", formatInt(int64(cacheMinimumRecordTtl)))
+	return r
+}
+
+// CacheMaximumRecordTtl sets the maximum TTL of cached records.
+func (r ApiSetSettingsRequest) CacheMaximumRecordTtl(cacheMaximumRecordTtl int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "cacheMaximumRecordTtl", formatInt(int64(cacheMaximumRecordTtl)))
+	return r
+}
+
+// CacheNegativeRecordTtl sets the TTL of cached negative answers.
+func (r ApiSetSettingsRequest) CacheNegativeRecordTtl(cacheNegativeRecordTtl int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "cacheNegativeRecordTtl", formatInt(int64(cacheNegativeRecordTtl)))
+	return r
+}
+
+// CacheFailureRecordTtl sets the TTL of cached resolution failures.
+func (r ApiSetSettingsRequest) CacheFailureRecordTtl(cacheFailureRecordTtl int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "cacheFailureRecordTtl", formatInt(int64(cacheFailureRecordTtl)))
+	return r
+}
+
+// CachePrefetchEligibility sets the minimum TTL in seconds of records eligible for prefetch.
+func (r ApiSetSettingsRequest) CachePrefetchEligibility(cachePrefetchEligibility int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "cachePrefetchEligibility", formatInt(int64(cachePrefetchEligibility)))
+	return r
+}
+
+// CachePrefetchTrigger sets the remaining TTL in seconds at which records are prefetched.
+func (r ApiSetSettingsRequest) CachePrefetchTrigger(cachePrefetchTrigger int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "cachePrefetchTrigger", formatInt(int64(cachePrefetchTrigger)))
+	return r
+}
+
+// CachePrefetchSampleIntervalInMinutes sets the sampling interval for prefetch eligibility.
+func (r ApiSetSettingsRequest) CachePrefetchSampleIntervalInMinutes(cachePrefetchSampleIntervalInMinutes int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "cachePrefetchSampleIntervalInMinutes", formatInt(int64(cachePrefetchSampleIntervalInMinutes)))
+	return r
+}
+
+// CachePrefetchSampleEligibilityHitsPerHour sets the hits per hour that make a record eligible for prefetch.
+func (r ApiSetSettingsRequest) CachePrefetchSampleEligibilityHitsPerHour(cachePrefetchSampleEligibilityHitsPerHour int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "cachePrefetchSampleEligibilityHitsPerHour", formatInt(int64(cachePrefetchSampleEligibilityHitsPerHour)))
+	return r
+}
+
+// EnableBlocking enables blocking.
+func (r ApiSetSettingsRequest) EnableBlocking(enableBlocking bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "enableBlocking", formatBool(enableBlocking))
+	return r
+}
+
+// AllowTxtBlockingReport answers TXT queries for blocked domains with a blocking report.
+func (r ApiSetSettingsRequest) AllowTxtBlockingReport(allowTxtBlockingReport bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "allowTxtBlockingReport", formatBool(allowTxtBlockingReport))
+	return r
+}
+
+// BlockingBypassList sets the networks exempt from blocking.
+func (r ApiSetSettingsRequest) BlockingBypassList(blockingBypassList []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "blockingBypassList", formatList(blockingBypassList))
+	return r
+}
+
+// BlockingType sets the answer for blocked domains: AnyAddress, NxDomain or CustomAddress.
+func (r ApiSetSettingsRequest) BlockingType(blockingType string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "blockingType", blockingType)
+	return r
+}
+
+// BlockingAnswerTtl sets the TTL of blocking answers.
+func (r ApiSetSettingsRequest) BlockingAnswerTtl(blockingAnswerTtl int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "blockingAnswerTtl", formatInt(int64(blockingAnswerTtl)))
+	return r
+}
+
+// CustomBlockingAddresses sets the addresses returned for blocked domains with the CustomAddress blocking type.
+func (r ApiSetSettingsRequest) CustomBlockingAddresses(customBlockingAddresses []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "customBlockingAddresses", formatList(customBlockingAddresses))
+	return r
+}
+
+// BlockListUrls sets the block list URLs. URLs prefixed with ! are allow lists.
+func (r ApiSetSettingsRequest) BlockListUrls(blockListUrls []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "blockListUrls", formatList(blockListUrls))
+	return r
+}
+
+// BlockListUrlUpdateIntervalHours sets the interval at which block lists are downloaded.
+func (r ApiSetSettingsRequest) BlockListUrlUpdateIntervalHours(blockListUrlUpdateIntervalHours int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "blockListUrlUpdateIntervalHours", formatInt(int64(blockListUrlUpdateIntervalHours)))
+	return r
+}
+
+// Forwarders sets the upstream forwarders. Set an empty list to resolve recursively.
+func (r ApiSetSettingsRequest) Forwarders(forwarders []string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "forwarders", formatList(forwarders))
+	return r
+}
+
+// ForwarderProtocol sets the protocol used with forwarders: Udp, Tcp, Tls, Https or Quic.
+func (r ApiSetSettingsRequest) ForwarderProtocol(forwarderProtocol string) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "forwarderProtocol", forwarderProtocol)
+	return r
+}
+
+// ConcurrentForwarding queries multiple forwarders concurrently.
+func (r ApiSetSettingsRequest) ConcurrentForwarding(concurrentForwarding bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "concurrentForwarding", formatBool(concurrentForwarding))
+	return r
+}
+
+// ForwarderRetries sets the retries per forwarder.
+func (r ApiSetSettingsRequest) ForwarderRetries(forwarderRetries int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "forwarderRetries", formatInt(int64(forwarderRetries)))
+	return r
+}
+
+// ForwarderTimeout sets the forwarder timeout in milliseconds.
+func (r ApiSetSettingsRequest) ForwarderTimeout(forwarderTimeout int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "forwarderTimeout", formatInt(int64(forwarderTimeout)))
+	return r
+}
+
+// ForwarderConcurrency sets the number of forwarders queried concurrently.
+func (r ApiSetSettingsRequest) ForwarderConcurrency(forwarderConcurrency int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "forwarderConcurrency", formatInt(int64(forwarderConcurrency)))
+	return r
+}
+
+// EnableLogging enables the error and audit log.
+func (r ApiSetSettingsRequest) EnableLogging(enableLogging bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "enableLogging", formatBool(enableLogging))
+	return r
+}
+
+// LogQueries writes every query to the log.
+func (r ApiSetSettingsRequest) LogQueries(logQueries bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "logQueries", formatBool(logQueries))
+	return r
+}
+
+// UseLocalTime writes log timestamps in local time.
+func (r ApiSetSettingsRequest) UseLocalTime(useLocalTime bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "useLocalTime", formatBool(useLocalTime))
+	return r
+}
+
+// MaxLogFileDays sets the days log files are kept.
+func (r ApiSetSettingsRequest) MaxLogFileDays(maxLogFileDays int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "maxLogFileDays", formatInt(int64(maxLogFileDays)))
+	return r
+}
+
+// EnableInMemoryStats keeps dashboard statistics in memory only.
+func (r ApiSetSettingsRequest) EnableInMemoryStats(enableInMemoryStats bool) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "enableInMemoryStats", formatBool(enableInMemoryStats))
+	return r
+}
+
+// MaxStatFileDays sets the days statistics files are kept.
+func (r ApiSetSettingsRequest) MaxStatFileDays(maxStatFileDays int32) ApiSetSettingsRequest {
+	r.query = withParam(r.query, "maxStatFileDays", formatInt(int64(maxStatFileDays)))
+	return r
+}
+
+func (r ApiSetSettingsRequest) Execute() (*DnsSettingsResponse, *http.Response, error) {
+	return r.ApiService.SetSettingsExecute(r)
+}
+
+// SetSettings changes the server settings. Settings that are not set on the
+// request are left unchanged; list settings set to an empty list are cleared.
+func (a *SettingsAPIService) SetSettings(ctx context.Context) ApiSetSettingsRequest {
+	return ApiSetSettingsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// SetSettingsExecute executes the request.
+func (a *SettingsAPIService) SetSettingsExecute(r ApiSetSettingsRequest) (*DnsSettingsResponse, *http.Response, error) {
+	return invokeForm[DnsSettingsResponse](r.ctx, a.client, "/api/settings/set", r.query)
+}
+
+type ApiForceUpdateBlockListsRequest struct {
+	ctx        context.Context
+	ApiService *SettingsAPIService
+}
+
+func (r ApiForceUpdateBlockListsRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.ForceUpdateBlockListsExecute(r)
+}
+
+// ForceUpdateBlockLists downloads the configured block lists immediately.
+func (a *SettingsAPIService) ForceUpdateBlockLists(ctx context.Context) ApiForceUpdateBlockListsRequest {
+	return ApiForceUpdateBlockListsRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// ForceUpdateBlockListsExecute executes the request.
+func (a *SettingsAPIService) ForceUpdateBlockListsExecute(r ApiForceUpdateBlockListsRequest) (*StatusResponse, *http.Response, error) {
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/settings/forceUpdateBlockLists", nil)
+}
+
+type ApiTemporaryDisableBlockingRequest struct {
+	ctx        context.Context
+	ApiService *SettingsAPIService
+	query      url.Values
+}
+
+// Minutes sets how long blocking stays disabled.
+func (r ApiTemporaryDisableBlockingRequest) Minutes(minutes int32) ApiTemporaryDisableBlockingRequest {
+	r.query = withParam(r.query, "minutes", formatInt(int64(minutes)))
+	return r
+}
+
+func (r ApiTemporaryDisableBlockingRequest) Execute() (*TemporaryDisableBlockingResponse, *http.Response, error) {
+	return r.ApiService.TemporaryDisableBlockingExecute(r)
+}
+
+// TemporaryDisableBlocking disables blocking for a number of minutes.
+func (a *SettingsAPIService) TemporaryDisableBlocking(ctx context.Context) ApiTemporaryDisableBlockingRequest {
+	return ApiTemporaryDisableBlockingRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// TemporaryDisableBlockingExecute executes the request.
+func (a *SettingsAPIService) TemporaryDisableBlockingExecute(r ApiTemporaryDisableBlockingRequest) (*TemporaryDisableBlockingResponse, *http.Response, error) {
+	if err := requireParam(r.query, "minutes"); err != nil {
+		return nil, nil, err
+	}
+	return invoke[TemporaryDisableBlockingResponse](r.ctx, a.client, http.MethodGet, "/api/settings/temporaryDisableBlocking", r.query)
+}
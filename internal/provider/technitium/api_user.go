@@ -0,0 +1,183 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// UserAPIService handles authentication and the current user's session.
+type UserAPIService service
+
+type ApiLoginRequest struct {
+	ctx        context.Context
+	ApiService *UserAPIService
+	query      url.Values
+}
+
+// User sets the username to log in as.
+func (r ApiLoginRequest) User(user string) ApiLoginRequest {
+	r.query = withParam(r.query, "user", user)
+	return r
+}
+
+// Pass sets the password of the user.
+func (r ApiLoginRequest) Pass(pass string) ApiLoginRequest {
+	r.query = withParam(r.query, "pass", pass)
+	return r
+}
+
+// Totp sets the time-based one-time password for users with 2FA enabled.
+func (r ApiLoginRequest) Totp(totp string) ApiLoginRequest {
+	r.query = withParam(r.query, "totp", totp)
+	return r
+}
+
+// IncludeInfo requests server and permission details in the response.
+func (r ApiLoginRequest) IncludeInfo(includeInfo bool) ApiLoginRequest {
+	r.query = withParam(r.query, "includeInfo", formatBool(includeInfo))
+	return r
+}
+
+func (r ApiLoginRequest) Execute() (*LoginResponse, *http.Response, error) {
+	return r.ApiService.LoginExecute(r)
+}
+
+// Login creates a session and returns its token.
+func (a *UserAPIService) Login(ctx context.Context) ApiLoginRequest {
+	return ApiLoginRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// LoginExecute executes the request.
+func (a *UserAPIService) LoginExecute(r ApiLoginRequest) (*LoginResponse, *http.Response, error) {
+	if err := requireParam(r.query, "user"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "pass"); err != nil {
+		return nil, nil, err
+	}
+	return invokeForm[LoginResponse](r.ctx, a.client, "/api/user/login", r.query)
+}
+
+type ApiLogoutRequest struct {
+	ctx        context.Context
+	ApiService *UserAPIService
+}
+
+func (r ApiLogoutRequest) Execute() (*StatusResponse, *http.Response, error) {
+	return r.ApiService.LogoutExecute(r)
+}
+
+// Logout ends the session the request is authenticated with.
+func (a *UserAPIService) Logout(ctx context.Context) ApiLogoutRequest {
+	return ApiLogoutRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// LogoutExecute executes the request.
+func (a *UserAPIService) LogoutExecute(r ApiLogoutRequest) (*StatusResponse, *http.Response, error) {
+	return invoke[StatusResponse](r.ctx, a.client, http.MethodGet, "/api/user/logout", nil)
+}
+
+type ApiGetSessionRequest struct {
+	ctx        context.Context
+	ApiService *UserAPIService
+}
+
+func (r ApiGetSessionRequest) Execute() (*LoginResponse, *http.Response, error) {
+	return r.ApiService.GetSessionExecute(r)
+}
+
+// GetSession returns the session the request is authenticated with, including
+// server information.
+func (a *UserAPIService) GetSession(ctx context.Context) ApiGetSessionRequest {
+	return ApiGetSessionRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// GetSessionExecute executes the request.
+func (a *UserAPIService) GetSessionExecute(r ApiGetSessionRequest) (*LoginResponse, *http.Response, error) {
+	return invoke[LoginResponse](r.ctx, a.client, http.MethodGet, "/api/user/session/get", nil)
+}
+
+type ApiCreateTokenRequest struct {
+	ctx        context.Context
+	ApiService *UserAPIService
+	query      url.Values
+}
+
+// User sets the username to create the token for.
+func (r ApiCreateTokenRequest) User(user string) ApiCreateTokenRequest {
+	r.query = withParam(r.query, "user", user)
+	return r
+}
+
+// Pass sets the password of the user.
+func (r ApiCreateTokenRequest) Pass(pass string) ApiCreateTokenRequest {
+	r.query = withParam(r.query, "pass", pass)
+	return r
+}
+
+// Totp sets the time-based one-time password for users with 2FA enabled.
+func (r ApiCreateTokenRequest) Totp(totp string) ApiCreateTokenRequest {
+	r.query = withParam(r.query, "totp", totp)
+	return r
+}
+
+// TokenName sets the name shown for the token in the sessions list.
+func (r ApiCreateTokenRequest) TokenName(tokenName string) ApiCreateTokenRequest {
+	r.query = withParam(r.query, "tokenName", tokenName)
+	return r
+}
+
+func (r ApiCreateTokenRequest) Execute() (*CreateTokenResponse, *http.Response, error) {
+	return r.ApiService.CreateTokenExecute(r)
+}
+
+// CreateToken creates a non-expiring API token for a user.
+func (a *UserAPIService) CreateToken(ctx context.Context) ApiCreateTokenRequest {
+	return ApiCreateTokenRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// CreateTokenExecute executes the request.
+func (a *UserAPIService) CreateTokenExecute(r ApiCreateTokenRequest) (*CreateTokenResponse, *http.Response, error) {
+	if err := requireParam(r.query, "user"); err != nil {
+		return nil, nil, err
+	}
+	if err := requireParam(r.query, "tokenName"); err != nil {
+		return nil, nil, err
+	}
+	return invokeForm[CreateTokenResponse](r.ctx, a.client, "/api/user/createToken", r.query)
+}
+
+type ApiCheckForUpdateRequest struct {
+	ctx        context.Context
+	ApiService *UserAPIService
+}
+
+func (r ApiCheckForUpdateRequest) Execute() (*CheckForUpdateResponse, *http.Response, error) {
+	return r.ApiService.CheckForUpdateExecute(r)
+}
+
+// CheckForUpdate returns the running server version and whether an update is available.
+func (a *UserAPIService) CheckForUpdate(ctx context.Context) ApiCheckForUpdateRequest {
+	return ApiCheckForUpdateRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// CheckForUpdateExecute executes the request.
+func (a *UserAPIService) CheckForUpdateExecute(r ApiCheckForUpdateRequest) (*CheckForUpdateResponse, *http.Response, error) {
+	return invoke[CheckForUpdateResponse](r.ctx, a.client, http.MethodGet, "/api/user/checkForUpdate", nil)
+}
@@ -0,0 +1,272 @@
+// Package technitium is a client for the Technitium DNS Server HTTP API.
+//
+// The API is documented at
+// https://github.com/TechnitiumSoftware/DnsServer/blob/master/APIDOCS.md. Every
+// call is a GET or POST to /api/... that answers with a JSON object carrying a
+// "status" field ("ok", "error" or "invalid-token") and, on success, a
+// "response" object. Calls that fail at the API level are not reported as Go
+// errors; callers inspect GetStatus and GetErrorMessage on the returned model.
+package technitium
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// APIClient manages communication with the Technitium DNS Server API.
+type APIClient struct {
+	cfg    *Configuration
+	common service // Reuse a single struct instead of allocating one for each service on the heap.
+
+	// API Services
+
+	AdminAPI *AdminAPIService
+
+	AllowedAPI *AllowedAPIService
+
+	AppsAPI *AppsAPIService
+
+	BlockedAPI *BlockedAPIService
+
+	CacheAPI *CacheAPIService
+
+	ClusterAPI *ClusterAPIService
+
+	DhcpAPI *DhcpAPIService
+
+	DnsRecordAPI *DnsRecordAPIService
+
+	DnsZoneAPI *DnsZoneAPIService
+
+	DnssecAPI *DnssecAPIService
+
+	LogsAPI *LogsAPIService
+
+	SettingsAPI *SettingsAPIService
+
+	UserAPI *UserAPIService
+}
+
+type service struct {
+	client *APIClient
+}
+
+// NewAPIClient creates a new API client. Calls are sent with cfg.HTTPClient,
+// or http.DefaultClient when it is nil.
+func NewAPIClient(cfg *Configuration) *APIClient {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+
+	c := &APIClient{}
+	c.cfg = cfg
+	c.common.client = c
+
+	// API Services
+	c.AdminAPI = (*AdminAPIService)(&c.common)
+	c.AllowedAPI = (*AllowedAPIService)(&c.common)
+	c.AppsAPI = (*AppsAPIService)(&c.common)
+	c.BlockedAPI = (*BlockedAPIService)(&c.common)
+	c.CacheAPI = (*CacheAPIService)(&c.common)
+	c.ClusterAPI = (*ClusterAPIService)(&c.common)
+	c.DhcpAPI = (*DhcpAPIService)(&c.common)
+	c.DnsRecordAPI = (*DnsRecordAPIService)(&c.common)
+	c.DnsZoneAPI = (*DnsZoneAPIService)(&c.common)
+	c.DnssecAPI = (*DnssecAPIService)(&c.common)
+	c.LogsAPI = (*LogsAPIService)(&c.common)
+	c.SettingsAPI = (*SettingsAPIService)(&c.common)
+	c.UserAPI = (*UserAPIService)(&c.common)
+
+	return c
+}
+
+// GetConfig returns the configuration of the client. It must not be modified
+// while calls are in flight.
+func (c *APIClient) GetConfig() *Configuration {
+	return c.cfg
+}
+
+// prepareRequest builds the HTTP request for an API call. Parameters are sent
+// in the query string unless a body is given, in which case the body is sent
+// as-is with the given content type.
+func (c *APIClient) prepareRequest(ctx context.Context, method string, path string, query url.Values, body []byte, contentType string) (*http.Request, error) {
+	base, err := c.cfg.ServerURL(0)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(strings.TrimSuffix(base, "/") + path)
+	if err != nil {
+		return nil, err
+	}
+	u.RawQuery = query.Encode()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", c.cfg.UserAgent)
+	}
+	for header, value := range c.cfg.DefaultHeader {
+		req.Header.Set(header, value)
+	}
+
+	return req, nil
+}
+
+// callAPI sends the request and decodes the response body into out. A nil
+// out discards the body; *[]byte receives it verbatim.
+func (c *APIClient) callAPI(ctx context.Context, method string, path string, query url.Values, body []byte, contentType string, out interface{}) (*http.Response, error) {
+	req, err := c.prepareRequest(ctx, method, path, query, body, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.cfg.HTTPClient.Do(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewBuffer(respBody))
+	if err != nil {
+		return resp, err
+	}
+
+	if resp.StatusCode >= 300 {
+		return resp, &ResponseError{
+			StatusCode: resp.StatusCode,
+			Message:    resp.Status,
+			Body:       respBody,
+		}
+	}
+
+	switch v := out.(type) {
+	case nil:
+		return resp, nil
+	case *[]byte:
+		*v = respBody
+		return resp, nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return resp, &ResponseError{
+			StatusCode: resp.StatusCode,
+			Message:    "invalid response body: " + err.Error(),
+			Body:       respBody,
+		}
+	}
+
+	return resp, nil
+}
+
+// invoke performs a call whose response is decoded into a new T.
+func invoke[T any](ctx context.Context, c *APIClient, method string, path string, query url.Values) (*T, *http.Response, error) {
+	var out T
+	resp, err := c.callAPI(ctx, method, path, query, nil, "", &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// invokeWithBody performs a POST call with a request body whose response is
+// decoded into a new T.
+func invokeWithBody[T any](ctx context.Context, c *APIClient, path string, query url.Values, body []byte, contentType string) (*T, *http.Response, error) {
+	var out T
+	resp, err := c.callAPI(ctx, http.MethodPost, path, query, body, contentType, &out)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &out, resp, nil
+}
+
+// invokeForm performs a POST call with the parameters sent as a form body
+// instead of the query string, keeping secrets such as passwords out of URLs.
+func invokeForm[T any](ctx context.Context, c *APIClient, path string, form url.Values) (*T, *http.Response, error) {
+	return invokeWithBody[T](ctx, c, path, nil, []byte(form.Encode()), "application/x-www-form-urlencoded")
+}
+
+// withParam returns a copy of query with key set to value, so request
+// builders can be copied and modified independently.
+func withParam(query url.Values, key string, value string) url.Values {
+	out := make(url.Values, len(query)+1)
+	for k, v := range query {
+		out[k] = v
+	}
+	out.Set(key, value)
+	return out
+}
+
+func formatBool(v bool) string {
+	return strconv.FormatBool(v)
+}
+
+func formatInt(v int64) string {
+	return strconv.FormatInt(v, 10)
+}
+
+// formatList encodes a list parameter the way Technitium expects it: comma
+// separated, or the literal "false" to clear the list on the server.
+func formatList(v []string) string {
+	if len(v) == 0 {
+		return "false"
+	}
+	return strings.Join(v, ",")
+}
+
+// formatTable encodes rows of a table parameter as a single pipe separated
+// list, or the literal "false" to clear the table on the server.
+func formatTable(rows [][]string) string {
+	if len(rows) == 0 {
+		return "false"
+	}
+	cells := make([]string, 0, len(rows)*len(rows[0]))
+	for _, row := range rows {
+		cells = append(cells, row...)
+	}
+	return strings.Join(cells, "|")
+}
+
+func requireParam(query url.Values, key string) error {
+	if query.Get(key) == "" {
+		return reportError("%s is required and must be specified", key)
+	}
+	return nil
+}
+
+// ResponseError is returned when the server answers with an HTTP error
+// status, or with a body that is not the expected JSON object.
+type ResponseError struct {
+	StatusCode int
+	Message    string
+	// Body is the raw response body.
+	Body []byte
+}
+
+func (e *ResponseError) Error() string {
+	return e.Message
+}
+
+// reportError returns an error for an invalid call made by the caller.
+func reportError(format string, a ...interface{}) error {
+	return fmt.Errorf(format, a...)
+}
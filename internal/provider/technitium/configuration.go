@@ -0,0 +1,51 @@
+package technitium
+
+import (
+	"net/http"
+)
+
+// ServerConfiguration stores the information about a Technitium web service endpoint.
+type ServerConfiguration struct {
+	URL         string
+	Description string
+}
+
+// ServerConfigurations stores multiple ServerConfiguration items.
+type ServerConfigurations []ServerConfiguration
+
+// Configuration stores the configuration of the API client.
+type Configuration struct {
+	DefaultHeader map[string]string
+	UserAgent     string
+	Servers       ServerConfigurations
+	HTTPClient    *http.Client
+}
+
+// NewConfiguration returns a new Configuration object pointing at a local
+// Technitium DNS Server on its default web service port.
+func NewConfiguration() *Configuration {
+	cfg := &Configuration{
+		DefaultHeader: make(map[string]string),
+		UserAgent:     "terraform-provider-technitium",
+		Servers: ServerConfigurations{
+			{
+				URL:         "http://localhost:5380",
+				Description: "Local Technitium DNS Server",
+			},
+		},
+	}
+	return cfg
+}
+
+// AddDefaultHeader adds a new HTTP header to the default header in the request.
+func (c *Configuration) AddDefaultHeader(key string, value string) {
+	c.DefaultHeader[key] = value
+}
+
+// ServerURL returns the base URL of the server at the given index.
+func (c *Configuration) ServerURL(index int) (string, error) {
+	if index < 0 || len(c.Servers) <= index {
+		return "", reportError("index %v out of range %v", index, len(c.Servers)-1)
+	}
+	return c.Servers[index].URL, nil
+}
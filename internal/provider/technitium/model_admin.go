@@ -0,0 +1,131 @@
+package technitium
+
+// ListUsersResponse is returned when listing users.
+type ListUsersResponse struct {
+	StatusResponse
+	Response *ListUsersResponseBody `json:"response,omitempty"`
+}
+
+// ListUsersResponseBody holds the users.
+type ListUsersResponseBody struct {
+	Users []User `json:"users,omitempty"`
+}
+
+// UserResponse is returned when creating or reading a user.
+type UserResponse struct {
+	StatusResponse
+	Response *User `json:"response,omitempty"`
+}
+
+// User is a web console user.
+type User struct {
+	DisplayName                  *string  `json:"displayName,omitempty"`
+	Username                     *string  `json:"username,omitempty"`
+	TotpEnabled                  *bool    `json:"totpEnabled,omitempty"`
+	Disabled                     *bool    `json:"disabled,omitempty"`
+	PreviousSessionLoggedOn      *string  `json:"previousSessionLoggedOn,omitempty"`
+	PreviousSessionRemoteAddress *string  `json:"previousSessionRemoteAddress,omitempty"`
+	RecentSessionLoggedOn        *string  `json:"recentSessionLoggedOn,omitempty"`
+	RecentSessionRemoteAddress   *string  `json:"recentSessionRemoteAddress,omitempty"`
+	SessionTimeoutSeconds        *int32   `json:"sessionTimeoutSeconds,omitempty"`
+	MemberOfGroups               []string `json:"memberOfGroups,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *ListUsersResponse) GetResponse() ListUsersResponseBody {
+	if o == nil || o.Response == nil {
+		var ret ListUsersResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *UserResponse) GetResponse() User {
+	if o == nil || o.Response == nil {
+		var ret User
+		return ret
+	}
+	return *o.Response
+}
+
+// GetDisplayName returns the DisplayName field value if set, zero value otherwise.
+func (o *User) GetDisplayName() string {
+	if o == nil || o.DisplayName == nil {
+		var ret string
+		return ret
+	}
+	return *o.DisplayName
+}
+
+// GetUsername returns the Username field value if set, zero value otherwise.
+func (o *User) GetUsername() string {
+	if o == nil || o.Username == nil {
+		var ret string
+		return ret
+	}
+	return *o.Username
+}
+
+// GetTotpEnabled returns the TotpEnabled field value if set, zero value otherwise.
+func (o *User) GetTotpEnabled() bool {
+	if o == nil || o.TotpEnabled == nil {
+		var ret bool
+		return ret
+	}
+	return *o.TotpEnabled
+}
+
+// GetDisabled returns the Disabled field value if set, zero value otherwise.
+func (o *User) GetDisabled() bool {
+	if o == nil || o.Disabled == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Disabled
+}
+
+// GetPreviousSessionLoggedOn returns the PreviousSessionLoggedOn field value if set, zero value otherwise.
+func (o *User) GetPreviousSessionLoggedOn() string {
+	if o == nil || o.PreviousSessionLoggedOn == nil {
+		var ret string
+		return ret
+	}
+	return *o.PreviousSessionLoggedOn
+}
+
+// GetPreviousSessionRemoteAddress returns the PreviousSessionRemoteAddress field value if set, zero value otherwise.
+func (o *User) GetPreviousSessionRemoteAddress() string {
+	if o == nil || o.PreviousSessionRemoteAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.PreviousSessionRemoteAddress
+}
+
+// GetRecentSessionLoggedOn returns the RecentSessionLoggedOn field value if set, zero value otherwise.
+func (o *User) GetRecentSessionLoggedOn() string {
+	if o == nil || o.RecentSessionLoggedOn == nil {
+		var ret string
+		return ret
+	}
+	return *o.RecentSessionLoggedOn
+}
+
+// GetRecentSessionRemoteAddress returns the RecentSessionRemoteAddress field value if set, zero value otherwise.
+func (o *User) GetRecentSessionRemoteAddress() string {
+	if o == nil || o.RecentSessionRemoteAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.RecentSessionRemoteAddress
+}
+
+// GetSessionTimeoutSeconds returns the SessionTimeoutSeconds field value if set, zero value otherwise.
+func (o *User) GetSessionTimeoutSeconds() int32 {
+	if o == nil || o.SessionTimeoutSeconds == nil {
+		var ret int32
+		return ret
+	}
+	return *o.SessionTimeoutSeconds
+}
@@ -0,0 +1,312 @@
+package technitium
+
+// ListAppsResponse is returned when listing installed apps.
+type ListAppsResponse struct {
+	StatusResponse
+	Response *ListAppsResponseBody `json:"response,omitempty"`
+}
+
+// ListAppsResponseBody holds the installed apps.
+type ListAppsResponseBody struct {
+	Apps []App `json:"apps,omitempty"`
+}
+
+// App is an installed DNS app.
+type App struct {
+	Name    *string  `json:"name,omitempty"`
+	Version *string  `json:"version,omitempty"`
+	DnsApps []DnsApp `json:"dnsApps,omitempty"`
+}
+
+// DnsApp is a class exported by an installed app.
+type DnsApp struct {
+	ClassPath                     *string `json:"classPath,omitempty"`
+	Description                   *string `json:"description,omitempty"`
+	IsAppRecordRequestHandler     *bool   `json:"isAppRecordRequestHandler,omitempty"`
+	RecordDataTemplate            *string `json:"recordDataTemplate,omitempty"`
+	IsRequestController           *bool   `json:"isRequestController,omitempty"`
+	IsAuthoritativeRequestHandler *bool   `json:"isAuthoritativeRequestHandler,omitempty"`
+	IsRequestBlockingHandler      *bool   `json:"isRequestBlockingHandler,omitempty"`
+	IsQueryLogger                 *bool   `json:"isQueryLogger,omitempty"`
+	IsPostProcessor               *bool   `json:"isPostProcessor,omitempty"`
+}
+
+// ListStoreAppsResponse is returned when listing the apps of the app store.
+type ListStoreAppsResponse struct {
+	StatusResponse
+	Response *ListStoreAppsResponseBody `json:"response,omitempty"`
+}
+
+// ListStoreAppsResponseBody holds the store apps.
+type ListStoreAppsResponseBody struct {
+	StoreApps []StoreApp `json:"storeApps,omitempty"`
+}
+
+// StoreApp is an app published in the DNS app store.
+type StoreApp struct {
+	Name             *string `json:"name,omitempty"`
+	Version          *string `json:"version,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	Url              *string `json:"url,omitempty"`
+	Size             *string `json:"size,omitempty"`
+	Installed        *bool   `json:"installed,omitempty"`
+	InstalledVersion *string `json:"installedVersion,omitempty"`
+	UpdateAvailable  *bool   `json:"updateAvailable,omitempty"`
+}
+
+// InstallAppResponse is returned when installing or updating an app.
+type InstallAppResponse struct {
+	StatusResponse
+	Response *InstallAppResponseBody `json:"response,omitempty"`
+}
+
+// InstallAppResponseBody holds the installed or updated app.
+type InstallAppResponseBody struct {
+	InstalledApp *App `json:"installedApp,omitempty"`
+	UpdatedApp   *App `json:"updatedApp,omitempty"`
+}
+
+// AppConfigResponse is returned when reading the config of an app.
+type AppConfigResponse struct {
+	StatusResponse
+	Response *AppConfigResponseBody `json:"response,omitempty"`
+}
+
+// AppConfigResponseBody holds the config file content of an app.
+type AppConfigResponseBody struct {
+	Config *string `json:"config,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *ListAppsResponse) GetResponse() ListAppsResponseBody {
+	if o == nil || o.Response == nil {
+		var ret ListAppsResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *App) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *App) GetVersion() string {
+	if o == nil || o.Version == nil {
+		var ret string
+		return ret
+	}
+	return *o.Version
+}
+
+// GetClassPath returns the ClassPath field value if set, zero value otherwise.
+func (o *DnsApp) GetClassPath() string {
+	if o == nil || o.ClassPath == nil {
+		var ret string
+		return ret
+	}
+	return *o.ClassPath
+}
+
+// GetDescription returns the Description field value if set, zero value otherwise.
+func (o *DnsApp) GetDescription() string {
+	if o == nil || o.Description == nil {
+		var ret string
+		return ret
+	}
+	return *o.Description
+}
+
+// GetIsAppRecordRequestHandler returns the IsAppRecordRequestHandler field value if set, zero value otherwise.
+func (o *DnsApp) GetIsAppRecordRequestHandler() bool {
+	if o == nil || o.IsAppRecordRequestHandler == nil {
+		var ret bool
+		return ret
+	}
+	return *o.IsAppRecordRequestHandler
+}
+
+// GetRecordDataTemplate returns the RecordDataTemplate field value if set, zero value otherwise.
+func (o *DnsApp) GetRecordDataTemplate() string {
+	if o == nil || o.RecordDataTemplate == nil {
+		var ret string
+		return ret
+	}
+	return *o.RecordDataTemplate
+}
+
+// GetIsRequestController returns the IsRequestController field value if set, zero value otherwise.
+func (o *DnsApp) GetIsRequestController() bool {
+	if o == nil || o.IsRequestController == nil {
+		var ret bool
+		return ret
+	}
+	return *o.IsRequestController
+}
+
+// GetIsAuthoritativeRequestHandler returns the IsAuthoritativeRequestHandler field value if set, zero value otherwise.
+func (o *DnsApp) GetIsAuthoritativeRequestHandler() bool {
+	if o == nil || o.IsAuthoritativeRequestHandler == nil {
+		var ret bool
+		return ret
+	}
+	return *o.IsAuthoritativeRequestHandler
+}
+
+// GetIsRequestBlockingHandler returns the IsRequestBlockingHandler field value if set, zero value otherwise.
+func (o *DnsApp) GetIsRequestBlockingHandler() bool {
+	if o == nil || o.IsRequestBlockingHandler == nil {
+		var ret bool
+		return ret
+	}
+	return *o.IsRequestBlockingHandler
+}
+
+// GetIsQueryLogger returns the IsQueryLogger field value if set, zero value otherwise.
+func (o *DnsApp) GetIsQueryLogger() bool {
+	if o == nil || o.IsQueryLogger == nil {
+		var ret bool
+		return ret
+	}
+	return *o.IsQueryLogger
+}
+
+// GetIsPostProcessor returns the IsPostProcessor field value if set, zero value otherwise.
+func (o *DnsApp) GetIsPostProcessor() bool {
+	if o == nil || o.IsPostProcessor == nil {
+		var ret bool
+		return ret
+	}
+	return *o.IsPostProcessor
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *ListStoreAppsResponse) GetResponse() ListStoreAppsResponseBody {
+	if o == nil || o.Response == nil {
+		var ret ListStoreAppsResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *StoreApp) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *StoreApp) GetVersion() string {
+	if o == nil || o.Version == nil {
+		var ret string
+		return ret
+	}
+	return *o.Version
+}
+
+// GetDescription returns the Description field value if set, zero value otherwise.
+func (o *StoreApp) GetDescription() string {
+	if o == nil || o.Description == nil {
+		var ret string
+		return ret
+	}
+	return *o.Description
+}
+
+// GetUrl returns the Url field value if set, zero value otherwise.
+func (o *StoreApp) GetUrl() string {
+	if o == nil || o.Url == nil {
+		var ret string
+		return ret
+	}
+	return *o.Url
+}
+
+// GetSize returns the Size field value if set, zero value otherwise.
+func (o *StoreApp) GetSize() string {
+	if o == nil || o.Size == nil {
+		var ret string
+		return ret
+	}
+	return *o.Size
+}
+
+// GetInstalled returns the Installed field value if set, zero value otherwise.
+func (o *StoreApp) GetInstalled() bool {
+	if o == nil || o.Installed == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Installed
+}
+
+// GetInstalledVersion returns the InstalledVersion field value if set, zero value otherwise.
+func (o *StoreApp) GetInstalledVersion() string {
+	if o == nil || o.InstalledVersion == nil {
+		var ret string
+		return ret
+	}
+	return *o.InstalledVersion
+}
+
+// GetUpdateAvailable returns the UpdateAvailable field value if set, zero value otherwise.
+func (o *StoreApp) GetUpdateAvailable() bool {
+	if o == nil || o.UpdateAvailable == nil {
+		var ret bool
+		return ret
+	}
+	return *o.UpdateAvailable
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *InstallAppResponse) GetResponse() InstallAppResponseBody {
+	if o == nil || o.Response == nil {
+		var ret InstallAppResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetInstalledApp returns the InstalledApp field value if set, zero value otherwise.
+func (o *InstallAppResponseBody) GetInstalledApp() App {
+	if o == nil || o.InstalledApp == nil {
+		var ret App
+		return ret
+	}
+	return *o.InstalledApp
+}
+
+// GetUpdatedApp returns the UpdatedApp field value if set, zero value otherwise.
+func (o *InstallAppResponseBody) GetUpdatedApp() App {
+	if o == nil || o.UpdatedApp == nil {
+		var ret App
+		return ret
+	}
+	return *o.UpdatedApp
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *AppConfigResponse) GetResponse() AppConfigResponseBody {
+	if o == nil || o.Response == nil {
+		var ret AppConfigResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetConfig returns the Config field value if set, zero value otherwise.
+func (o *AppConfigResponseBody) GetConfig() string {
+	if o == nil || o.Config == nil {
+		var ret string
+		return ret
+	}
+	return *o.Config
+}
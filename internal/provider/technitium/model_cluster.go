@@ -0,0 +1,189 @@
+package technitium
+
+// ClusterStateResponse is returned by the cluster calls.
+type ClusterStateResponse struct {
+	StatusResponse
+	Response *ClusterState `json:"response,omitempty"`
+}
+
+// ClusterState is the cluster configuration as seen by one node.
+type ClusterState struct {
+	ClusterInitialized              *bool         `json:"clusterInitialized,omitempty"`
+	DnsServerDomain                 *string       `json:"dnsServerDomain,omitempty"`
+	Version                         *string       `json:"version,omitempty"`
+	ClusterDomain                   *string       `json:"clusterDomain,omitempty"`
+	HeartbeatRefreshIntervalSeconds *int32        `json:"heartbeatRefreshIntervalSeconds,omitempty"`
+	HeartbeatRetryIntervalSeconds   *int32        `json:"heartbeatRetryIntervalSeconds,omitempty"`
+	ConfigRefreshIntervalSeconds    *int32        `json:"configRefreshIntervalSeconds,omitempty"`
+	ConfigRetryIntervalSeconds      *int32        `json:"configRetryIntervalSeconds,omitempty"`
+	ConfigLastSynced                *string       `json:"configLastSynced,omitempty"`
+	ClusterNodes                    []ClusterNode `json:"clusterNodes,omitempty"`
+	ServerIpAddresses               []string      `json:"serverIpAddresses,omitempty"`
+}
+
+// ClusterNode is a member of a cluster. State is "Self" for the node that
+// answered, otherwise the health seen by it, such as "Connected" or
+// "Unreachable".
+type ClusterNode struct {
+	Id          *int32   `json:"id,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	Url         *string  `json:"url,omitempty"`
+	IpAddresses []string `json:"ipAddresses,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+	State       *string  `json:"state,omitempty"`
+	UpSince     *string  `json:"upSince,omitempty"`
+	LastSeen    *string  `json:"lastSeen,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *ClusterStateResponse) GetResponse() ClusterState {
+	if o == nil || o.Response == nil {
+		var ret ClusterState
+		return ret
+	}
+	return *o.Response
+}
+
+// GetClusterInitialized returns the ClusterInitialized field value if set, zero value otherwise.
+func (o *ClusterState) GetClusterInitialized() bool {
+	if o == nil || o.ClusterInitialized == nil {
+		var ret bool
+		return ret
+	}
+	return *o.ClusterInitialized
+}
+
+// GetDnsServerDomain returns the DnsServerDomain field value if set, zero value otherwise.
+func (o *ClusterState) GetDnsServerDomain() string {
+	if o == nil || o.DnsServerDomain == nil {
+		var ret string
+		return ret
+	}
+	return *o.DnsServerDomain
+}
+
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *ClusterState) GetVersion() string {
+	if o == nil || o.Version == nil {
+		var ret string
+		return ret
+	}
+	return *o.Version
+}
+
+// GetClusterDomain returns the ClusterDomain field value if set, zero value otherwise.
+func (o *ClusterState) GetClusterDomain() string {
+	if o == nil || o.ClusterDomain == nil {
+		var ret string
+		return ret
+	}
+	return *o.ClusterDomain
+}
+
+// GetHeartbeatRefreshIntervalSeconds returns the HeartbeatRefreshIntervalSeconds field value if set, zero value otherwise.
+func (o *ClusterState) GetHeartbeatRefreshIntervalSeconds() int32 {
+	if o == nil || o.HeartbeatRefreshIntervalSeconds == nil {
+		var ret int32
+		return ret
+	}
+	return *o.HeartbeatRefreshIntervalSeconds
+}
+
+// GetHeartbeatRetryIntervalSeconds returns the HeartbeatRetryIntervalSeconds field value if set, zero value otherwise.
+func (o *ClusterState) GetHeartbeatRetryIntervalSeconds() int32 {
+	if o == nil || o.HeartbeatRetryIntervalSeconds == nil {
+		var ret int32
+		return ret
+	}
+	return *o.HeartbeatRetryIntervalSeconds
+}
+
+// GetConfigRefreshIntervalSeconds returns the ConfigRefreshIntervalSeconds field value if set, zero value otherwise.
+func (o *ClusterState) GetConfigRefreshIntervalSeconds() int32 {
+	if o == nil || o.ConfigRefreshIntervalSeconds == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ConfigRefreshIntervalSeconds
+}
+
+// GetConfigRetryIntervalSeconds returns the ConfigRetryIntervalSeconds field value if set, zero value otherwise.
+func (o *ClusterState) GetConfigRetryIntervalSeconds() int32 {
+	if o == nil || o.ConfigRetryIntervalSeconds == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ConfigRetryIntervalSeconds
+}
+
+// GetConfigLastSynced returns the ConfigLastSynced field value if set, zero value otherwise.
+func (o *ClusterState) GetConfigLastSynced() string {
+	if o == nil || o.ConfigLastSynced == nil {
+		var ret string
+		return ret
+	}
+	return *o.ConfigLastSynced
+}
+
+// GetId returns the Id field value if set, zero value otherwise.
+func (o *ClusterNode) GetId() int32 {
+	if o == nil || o.Id == nil {
+		var ret int32
+		return ret
+	}
+	return *o.Id
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *ClusterNode) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetUrl returns the Url field value if set, zero value otherwise.
+func (o *ClusterNode) GetUrl() string {
+	if o == nil || o.Url == nil {
+		var ret string
+		return ret
+	}
+	return *o.Url
+}
+
+// GetType returns the Type field value if set, zero value otherwise.
+func (o *ClusterNode) GetType() string {
+	if o == nil || o.Type == nil {
+		var ret string
+		return ret
+	}
+	return *o.Type
+}
+
+// GetState returns the State field value if set, zero value otherwise.
+func (o *ClusterNode) GetState() string {
+	if o == nil || o.State == nil {
+		var ret string
+		return ret
+	}
+	return *o.State
+}
+
+// GetUpSince returns the UpSince field value if set, zero value otherwise.
+func (o *ClusterNode) GetUpSince() string {
+	if o == nil || o.UpSince == nil {
+		var ret string
+		return ret
+	}
+	return *o.UpSince
+}
+
+// GetLastSeen returns the LastSeen field value if set, zero value otherwise.
+func (o *ClusterNode) GetLastSeen() string {
+	if o == nil || o.LastSeen == nil {
+		var ret string
+		return ret
+	}
+	return *o.LastSeen
+}
@@ -0,0 +1,600 @@
+package technitium
+
+// ListDhcpLeasesResponse is returned when listing DHCP leases.
+type ListDhcpLeasesResponse struct {
+	StatusResponse
+	Response *ListDhcpLeasesResponseBody `json:"response,omitempty"`
+}
+
+// ListDhcpLeasesResponseBody holds the leases of all scopes.
+type ListDhcpLeasesResponseBody struct {
+	Leases []DhcpLease `json:"leases,omitempty"`
+}
+
+// DhcpLease is a lease handed out by a DHCP scope.
+type DhcpLease struct {
+	Scope            *string `json:"scope,omitempty"`
+	Type             *string `json:"type,omitempty"`
+	HardwareAddress  *string `json:"hardwareAddress,omitempty"`
+	ClientIdentifier *string `json:"clientIdentifier,omitempty"`
+	Address          *string `json:"address,omitempty"`
+	HostName         *string `json:"hostName,omitempty"`
+	LeaseObtained    *string `json:"leaseObtained,omitempty"`
+	LeaseExpires     *string `json:"leaseExpires,omitempty"`
+}
+
+// ListDhcpScopesResponse is returned when listing DHCP scopes.
+type ListDhcpScopesResponse struct {
+	StatusResponse
+	Response *ListDhcpScopesResponseBody `json:"response,omitempty"`
+}
+
+// ListDhcpScopesResponseBody holds the scope summaries.
+type ListDhcpScopesResponseBody struct {
+	Scopes []DhcpScopeSummary `json:"scopes,omitempty"`
+}
+
+// DhcpScopeSummary is the short description of a scope returned by the list call.
+type DhcpScopeSummary struct {
+	Name             *string `json:"name,omitempty"`
+	Enabled          *bool   `json:"enabled,omitempty"`
+	StartingAddress  *string `json:"startingAddress,omitempty"`
+	EndingAddress    *string `json:"endingAddress,omitempty"`
+	SubnetMask       *string `json:"subnetMask,omitempty"`
+	NetworkAddress   *string `json:"networkAddress,omitempty"`
+	BroadcastAddress *string `json:"broadcastAddress,omitempty"`
+}
+
+// DhcpScopeResponse is returned when reading a DHCP scope.
+type DhcpScopeResponse struct {
+	StatusResponse
+	Response *DhcpScope `json:"response,omitempty"`
+}
+
+// DhcpScope holds the full configuration of a DHCP scope.
+type DhcpScope struct {
+	Name                                 *string             `json:"name,omitempty"`
+	StartingAddress                      *string             `json:"startingAddress,omitempty"`
+	EndingAddress                        *string             `json:"endingAddress,omitempty"`
+	SubnetMask                           *string             `json:"subnetMask,omitempty"`
+	LeaseTimeDays                        *int32              `json:"leaseTimeDays,omitempty"`
+	LeaseTimeHours                       *int32              `json:"leaseTimeHours,omitempty"`
+	LeaseTimeMinutes                     *int32              `json:"leaseTimeMinutes,omitempty"`
+	OfferDelayTime                       *int32              `json:"offerDelayTime,omitempty"`
+	PingCheckEnabled                     *bool               `json:"pingCheckEnabled,omitempty"`
+	PingCheckTimeout                     *int32              `json:"pingCheckTimeout,omitempty"`
+	PingCheckRetries                     *int32              `json:"pingCheckRetries,omitempty"`
+	DomainName                           *string             `json:"domainName,omitempty"`
+	DomainSearchList                     []string            `json:"domainSearchList,omitempty"`
+	DnsUpdates                           *bool               `json:"dnsUpdates,omitempty"`
+	DnsTtl                               *int32              `json:"dnsTtl,omitempty"`
+	ServerAddress                        *string             `json:"serverAddress,omitempty"`
+	ServerHostName                       *string             `json:"serverHostName,omitempty"`
+	BootFileName                         *string             `json:"bootFileName,omitempty"`
+	RouterAddress                        *string             `json:"routerAddress,omitempty"`
+	UseThisDnsServer                     *bool               `json:"useThisDnsServer,omitempty"`
+	DnsServers                           []string            `json:"dnsServers,omitempty"`
+	WinsServers                          []string            `json:"winsServers,omitempty"`
+	NtpServers                           []string            `json:"ntpServers,omitempty"`
+	NtpServerDomainNames                 []string            `json:"ntpServerDomainNames,omitempty"`
+	StaticRoutes                         []DhcpStaticRoute   `json:"staticRoutes,omitempty"`
+	VendorInfo                           []DhcpVendorInfo    `json:"vendorInfo,omitempty"`
+	CapwapAcIpAddresses                  []string            `json:"capwapAcIpAddresses,omitempty"`
+	TftpServerAddresses                  []string            `json:"tftpServerAddresses,omitempty"`
+	GenericOptions                       []DhcpGenericOption `json:"genericOptions,omitempty"`
+	Exclusions                           []DhcpExclusion     `json:"exclusions,omitempty"`
+	ReservedLeases                       []DhcpReservedLease `json:"reservedLeases,omitempty"`
+	AllowOnlyReservedLeases              *bool               `json:"allowOnlyReservedLeases,omitempty"`
+	BlockLocallyAdministeredMacAddresses *bool               `json:"blockLocallyAdministeredMacAddresses,omitempty"`
+	IgnoreClientIdentifierOption         *bool               `json:"ignoreClientIdentifierOption,omitempty"`
+}
+
+// DhcpStaticRoute is a classless static route option of a scope.
+type DhcpStaticRoute struct {
+	Destination *string `json:"destination,omitempty"`
+	SubnetMask  *string `json:"subnetMask,omitempty"`
+	Router      *string `json:"router,omitempty"`
+}
+
+// DhcpVendorInfo is vendor specific information sent to matching clients.
+type DhcpVendorInfo struct {
+	Identifier  *string `json:"identifier,omitempty"`
+	Information *string `json:"information,omitempty"`
+}
+
+// DhcpGenericOption is a raw DHCP option given as a hex string.
+type DhcpGenericOption struct {
+	Code  *int32  `json:"code,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// DhcpExclusion is an address range of a scope that is never leased.
+type DhcpExclusion struct {
+	StartingAddress *string `json:"startingAddress,omitempty"`
+	EndingAddress   *string `json:"endingAddress,omitempty"`
+}
+
+// DhcpReservedLease pins a hardware address to an address of a scope.
+type DhcpReservedLease struct {
+	HostName        *string `json:"hostName,omitempty"`
+	HardwareAddress *string `json:"hardwareAddress,omitempty"`
+	Address         *string `json:"address,omitempty"`
+	Comments        *string `json:"comments,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *ListDhcpLeasesResponse) GetResponse() ListDhcpLeasesResponseBody {
+	if o == nil || o.Response == nil {
+		var ret ListDhcpLeasesResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetScope returns the Scope field value if set, zero value otherwise.
+func (o *DhcpLease) GetScope() string {
+	if o == nil || o.Scope == nil {
+		var ret string
+		return ret
+	}
+	return *o.Scope
+}
+
+// GetType returns the Type field value if set, zero value otherwise.
+func (o *DhcpLease) GetType() string {
+	if o == nil || o.Type == nil {
+		var ret string
+		return ret
+	}
+	return *o.Type
+}
+
+// GetHardwareAddress returns the HardwareAddress field value if set, zero value otherwise.
+func (o *DhcpLease) GetHardwareAddress() string {
+	if o == nil || o.HardwareAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.HardwareAddress
+}
+
+// GetClientIdentifier returns the ClientIdentifier field value if set, zero value otherwise.
+func (o *DhcpLease) GetClientIdentifier() string {
+	if o == nil || o.ClientIdentifier == nil {
+		var ret string
+		return ret
+	}
+	return *o.ClientIdentifier
+}
+
+// GetAddress returns the Address field value if set, zero value otherwise.
+func (o *DhcpLease) GetAddress() string {
+	if o == nil || o.Address == nil {
+		var ret string
+		return ret
+	}
+	return *o.Address
+}
+
+// GetHostName returns the HostName field value if set, zero value otherwise.
+func (o *DhcpLease) GetHostName() string {
+	if o == nil || o.HostName == nil {
+		var ret string
+		return ret
+	}
+	return *o.HostName
+}
+
+// GetLeaseObtained returns the LeaseObtained field value if set, zero value otherwise.
+func (o *DhcpLease) GetLeaseObtained() string {
+	if o == nil || o.LeaseObtained == nil {
+		var ret string
+		return ret
+	}
+	return *o.LeaseObtained
+}
+
+// GetLeaseExpires returns the LeaseExpires field value if set, zero value otherwise.
+func (o *DhcpLease) GetLeaseExpires() string {
+	if o == nil || o.LeaseExpires == nil {
+		var ret string
+		return ret
+	}
+	return *o.LeaseExpires
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *ListDhcpScopesResponse) GetResponse() ListDhcpScopesResponseBody {
+	if o == nil || o.Response == nil {
+		var ret ListDhcpScopesResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *DhcpScopeSummary) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetEnabled returns the Enabled field value if set, zero value otherwise.
+func (o *DhcpScopeSummary) GetEnabled() bool {
+	if o == nil || o.Enabled == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Enabled
+}
+
+// GetStartingAddress returns the StartingAddress field value if set, zero value otherwise.
+func (o *DhcpScopeSummary) GetStartingAddress() string {
+	if o == nil || o.StartingAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.StartingAddress
+}
+
+// GetEndingAddress returns the EndingAddress field value if set, zero value otherwise.
+func (o *DhcpScopeSummary) GetEndingAddress() string {
+	if o == nil || o.EndingAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.EndingAddress
+}
+
+// GetSubnetMask returns the SubnetMask field value if set, zero value otherwise.
+func (o *DhcpScopeSummary) GetSubnetMask() string {
+	if o == nil || o.SubnetMask == nil {
+		var ret string
+		return ret
+	}
+	return *o.SubnetMask
+}
+
+// GetNetworkAddress returns the NetworkAddress field value if set, zero value otherwise.
+func (o *DhcpScopeSummary) GetNetworkAddress() string {
+	if o == nil || o.NetworkAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.NetworkAddress
+}
+
+// GetBroadcastAddress returns the BroadcastAddress field value if set, zero value otherwise.
+func (o *DhcpScopeSummary) GetBroadcastAddress() string {
+	if o == nil || o.BroadcastAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.BroadcastAddress
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *DhcpScopeResponse) GetResponse() DhcpScope {
+	if o == nil || o.Response == nil {
+		var ret DhcpScope
+		return ret
+	}
+	return *o.Response
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *DhcpScope) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetStartingAddress returns the StartingAddress field value if set, zero value otherwise.
+func (o *DhcpScope) GetStartingAddress() string {
+	if o == nil || o.StartingAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.StartingAddress
+}
+
+// GetEndingAddress returns the EndingAddress field value if set, zero value otherwise.
+func (o *DhcpScope) GetEndingAddress() string {
+	if o == nil || o.EndingAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.EndingAddress
+}
+
+// GetSubnetMask returns the SubnetMask field value if set, zero value otherwise.
+func (o *DhcpScope) GetSubnetMask() string {
+	if o == nil || o.SubnetMask == nil {
+		var ret string
+		return ret
+	}
+	return *o.SubnetMask
+}
+
+// GetLeaseTimeDays returns the LeaseTimeDays field value if set, zero value otherwise.
+func (o *DhcpScope) GetLeaseTimeDays() int32 {
+	if o == nil || o.LeaseTimeDays == nil {
+		var ret int32
+		return ret
+	}
+	return *o.LeaseTimeDays
+}
+
+// GetLeaseTimeHours returns the LeaseTimeHours field value if set, zero value otherwise.
+func (o *DhcpScope) GetLeaseTimeHours() int32 {
+	if o == nil || o.LeaseTimeHours == nil {
+		var ret int32
+		return ret
+	}
+	return *o.LeaseTimeHours
+}
+
+// GetLeaseTimeMinutes returns the LeaseTimeMinutes field value if set, zero value otherwise.
+func (o *DhcpScope) GetLeaseTimeMinutes() int32 {
+	if o == nil || o.LeaseTimeMinutes == nil {
+		var ret int32
+		return ret
+	}
+	return *o.LeaseTimeMinutes
+}
+
+// GetOfferDelayTime returns the OfferDelayTime field value if set, zero value otherwise.
+func (o *DhcpScope) GetOfferDelayTime() int32 {
+	if o == nil || o.OfferDelayTime == nil {
+		var ret int32
+		return ret
+	}
+	return *o.OfferDelayTime
+}
+
+// GetPingCheckEnabled returns the PingCheckEnabled field value if set, zero value otherwise.
+func (o *DhcpScope) GetPingCheckEnabled() bool {
+	if o == nil || o.PingCheckEnabled == nil {
+		var ret bool
+		return ret
+	}
+	return *o.PingCheckEnabled
+}
+
+// GetPingCheckTimeout returns the PingCheckTimeout field value if set, zero value otherwise.
+func (o *DhcpScope) GetPingCheckTimeout() int32 {
+	if o == nil || o.PingCheckTimeout == nil {
+		var ret int32
+		return ret
+	}
+	return *o.PingCheckTimeout
+}
+
+// GetPingCheckRetries returns the PingCheckRetries field value if set, zero value otherwise.
+func (o *DhcpScope) GetPingCheckRetries() int32 {
+	if o == nil || o.PingCheckRetries == nil {
+		var ret int32
+		return ret
+	}
+	return *o.PingCheckRetries
+}
+
+// GetDomainName returns the DomainName field value if set, zero value otherwise.
+func (o *DhcpScope) GetDomainName() string {
+	if o == nil || o.DomainName == nil {
+		var ret string
+		return ret
+	}
+	return *o.DomainName
+}
+
+// GetDnsUpdates returns the DnsUpdates field value if set, zero value otherwise.
+func (o *DhcpScope) GetDnsUpdates() bool {
+	if o == nil || o.DnsUpdates == nil {
+		var ret bool
+		return ret
+	}
+	return *o.DnsUpdates
+}
+
+// GetDnsTtl returns the DnsTtl field value if set, zero value otherwise.
+func (o *DhcpScope) GetDnsTtl() int32 {
+	if o == nil || o.DnsTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.DnsTtl
+}
+
+// GetServerAddress returns the ServerAddress field value if set, zero value otherwise.
+func (o *DhcpScope) GetServerAddress() string {
+	if o == nil || o.ServerAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.ServerAddress
+}
+
+// GetServerHostName returns the ServerHostName field value if set, zero value otherwise.
+func (o *DhcpScope) GetServerHostName() string {
+	if o == nil || o.ServerHostName == nil {
+		var ret string
+		return ret
+	}
+	return *o.ServerHostName
+}
+
+// GetBootFileName returns the BootFileName field value if set, zero value otherwise.
+func (o *DhcpScope) GetBootFileName() string {
+	if o == nil || o.BootFileName == nil {
+		var ret string
+		return ret
+	}
+	return *o.BootFileName
+}
+
+// GetRouterAddress returns the RouterAddress field value if set, zero value otherwise.
+func (o *DhcpScope) GetRouterAddress() string {
+	if o == nil || o.RouterAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.RouterAddress
+}
+
+// GetUseThisDnsServer returns the UseThisDnsServer field value if set, zero value otherwise.
+func (o *DhcpScope) GetUseThisDnsServer() bool {
+	if o == nil || o.UseThisDnsServer == nil {
+		var ret bool
+		return ret
+	}
+	return *o.UseThisDnsServer
+}
+
+// GetAllowOnlyReservedLeases returns the AllowOnlyReservedLeases field value if set, zero value otherwise.
+func (o *DhcpScope) GetAllowOnlyReservedLeases() bool {
+	if o == nil || o.AllowOnlyReservedLeases == nil {
+		var ret bool
+		return ret
+	}
+	return *o.AllowOnlyReservedLeases
+}
+
+// GetBlockLocallyAdministeredMacAddresses returns the BlockLocallyAdministeredMacAddresses field value if set, zero value otherwise.
+func (o *DhcpScope) GetBlockLocallyAdministeredMacAddresses() bool {
+	if o == nil || o.BlockLocallyAdministeredMacAddresses == nil {
+		var ret bool
+		return ret
+	}
+	return *o.BlockLocallyAdministeredMacAddresses
+}
+
+// GetIgnoreClientIdentifierOption returns the IgnoreClientIdentifierOption field value if set, zero value otherwise.
+func (o *DhcpScope) GetIgnoreClientIdentifierOption() bool {
+	if o == nil || o.IgnoreClientIdentifierOption == nil {
+		var ret bool
+		return ret
+	}
+	return *o.IgnoreClientIdentifierOption
+}
+
+// GetDestination returns the Destination field value if set, zero value otherwise.
+func (o *DhcpStaticRoute) GetDestination() string {
+	if o == nil || o.Destination == nil {
+		var ret string
+		return ret
+	}
+	return *o.Destination
+}
+
+// GetSubnetMask returns the SubnetMask field value if set, zero value otherwise.
+func (o *DhcpStaticRoute) GetSubnetMask() string {
+	if o == nil || o.SubnetMask == nil {
+		var ret string
+		return ret
+	}
+	return *o.SubnetMask
+}
+
+// GetRouter returns the Router field value if set, zero value otherwise.
+func (o *DhcpStaticRoute) GetRouter() string {
+	if o == nil || o.Router == nil {
+		var ret string
+		return ret
+	}
+	return *o.Router
+}
+
+// GetIdentifier returns the Identifier field value if set, zero value otherwise.
+func (o *DhcpVendorInfo) GetIdentifier() string {
+	if o == nil || o.Identifier == nil {
+		var ret string
+		return ret
+	}
+	return *o.Identifier
+}
+
+// GetInformation returns the Information field value if set, zero value otherwise.
+func (o *DhcpVendorInfo) GetInformation() string {
+	if o == nil || o.Information == nil {
+		var ret string
+		return ret
+	}
+	return *o.Information
+}
+
+// GetCode returns the Code field value if set, zero value otherwise.
+func (o *DhcpGenericOption) GetCode() int32 {
+	if o == nil || o.Code == nil {
+		var ret int32
+		return ret
+	}
+	return *o.Code
+}
+
+// GetValue returns the Value field value if set, zero value otherwise.
+func (o *DhcpGenericOption) GetValue() string {
+	if o == nil || o.Value == nil {
+		var ret string
+		return ret
+	}
+	return *o.Value
+}
+
+// GetStartingAddress returns the StartingAddress field value if set, zero value otherwise.
+func (o *DhcpExclusion) GetStartingAddress() string {
+	if o == nil || o.StartingAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.StartingAddress
+}
+
+// GetEndingAddress returns the EndingAddress field value if set, zero value otherwise.
+func (o *DhcpExclusion) GetEndingAddress() string {
+	if o == nil || o.EndingAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.EndingAddress
+}
+
+// GetHostName returns the HostName field value if set, zero value otherwise.
+func (o *DhcpReservedLease) GetHostName() string {
+	if o == nil || o.HostName == nil {
+		var ret string
+		return ret
+	}
+	return *o.HostName
+}
+
+// GetHardwareAddress returns the HardwareAddress field value if set, zero value otherwise.
+func (o *DhcpReservedLease) GetHardwareAddress() string {
+	if o == nil || o.HardwareAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.HardwareAddress
+}
+
+// GetAddress returns the Address field value if set, zero value otherwise.
+func (o *DhcpReservedLease) GetAddress() string {
+	if o == nil || o.Address == nil {
+		var ret string
+		return ret
+	}
+	return *o.Address
+}
+
+// GetComments returns the Comments field value if set, zero value otherwise.
+func (o *DhcpReservedLease) GetComments() string {
+	if o == nil || o.Comments == nil {
+		var ret string
+		return ret
+	}
+	return *o.Comments
+}
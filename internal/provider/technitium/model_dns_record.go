@@ -0,0 +1,289 @@
+package technitium
+
+// DnsRecordResponse is returned when adding or updating a record.
+type DnsRecordResponse struct {
+	StatusResponse
+	Response *DnsRecordResponseBody `json:"response,omitempty"`
+}
+
+// DnsRecordResponseBody holds the zone and the record that was written.
+type DnsRecordResponseBody struct {
+	Zone          *DnsZone   `json:"zone,omitempty"`
+	AddedRecord   *DnsRecord `json:"addedRecord,omitempty"`
+	UpdatedRecord *DnsRecord `json:"updatedRecord,omitempty"`
+}
+
+// GetDnsRecordsResponse is returned when reading the records of a domain.
+type GetDnsRecordsResponse struct {
+	StatusResponse
+	Response *GetDnsRecordsResponseBody `json:"response,omitempty"`
+}
+
+// GetDnsRecordsResponseBody holds the zone and its matching records.
+type GetDnsRecordsResponseBody struct {
+	Zone    *DnsZone    `json:"zone,omitempty"`
+	Records []DnsRecord `json:"records,omitempty"`
+}
+
+// DnsRecord is a single resource record.
+type DnsRecord struct {
+	Disabled     *bool           `json:"disabled,omitempty"`
+	Name         *string         `json:"name,omitempty"`
+	Type         *string         `json:"type,omitempty"`
+	Ttl          *int32          `json:"ttl,omitempty"`
+	RData        *DnsRecordRData `json:"rData,omitempty"`
+	DnssecStatus *string         `json:"dnssecStatus,omitempty"`
+	Comments     *string         `json:"comments,omitempty"`
+	LastUsedOn   *string         `json:"lastUsedOn,omitempty"`
+}
+
+// DnsRecordRData holds the record data. Only the fields of the record type
+// are set.
+type DnsRecordRData struct {
+	IpAddress  *string `json:"ipAddress,omitempty"`
+	NameServer *string `json:"nameServer,omitempty"`
+	Cname      *string `json:"cname,omitempty"`
+	PtrName    *string `json:"ptrName,omitempty"`
+	Exchange   *string `json:"exchange,omitempty"`
+	Preference *int32  `json:"preference,omitempty"`
+	Text       *string `json:"text,omitempty"`
+	Priority   *int32  `json:"priority,omitempty"`
+	Weight     *int32  `json:"weight,omitempty"`
+	Port       *int32  `json:"port,omitempty"`
+	Target     *string `json:"target,omitempty"`
+	Value      *string `json:"value,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *DnsRecordResponse) GetResponse() DnsRecordResponseBody {
+	if o == nil || o.Response == nil {
+		var ret DnsRecordResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetZone returns the Zone field value if set, zero value otherwise.
+func (o *DnsRecordResponseBody) GetZone() DnsZone {
+	if o == nil || o.Zone == nil {
+		var ret DnsZone
+		return ret
+	}
+	return *o.Zone
+}
+
+// GetAddedRecord returns the AddedRecord field value if set, zero value otherwise.
+func (o *DnsRecordResponseBody) GetAddedRecord() DnsRecord {
+	if o == nil || o.AddedRecord == nil {
+		var ret DnsRecord
+		return ret
+	}
+	return *o.AddedRecord
+}
+
+// GetUpdatedRecord returns the UpdatedRecord field value if set, zero value otherwise.
+func (o *DnsRecordResponseBody) GetUpdatedRecord() DnsRecord {
+	if o == nil || o.UpdatedRecord == nil {
+		var ret DnsRecord
+		return ret
+	}
+	return *o.UpdatedRecord
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *GetDnsRecordsResponse) GetResponse() GetDnsRecordsResponseBody {
+	if o == nil || o.Response == nil {
+		var ret GetDnsRecordsResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetZone returns the Zone field value if set, zero value otherwise.
+func (o *GetDnsRecordsResponseBody) GetZone() DnsZone {
+	if o == nil || o.Zone == nil {
+		var ret DnsZone
+		return ret
+	}
+	return *o.Zone
+}
+
+// GetDisabled returns the Disabled field value if set, zero value otherwise.
+func (o *DnsRecord) GetDisabled() bool {
+	if o == nil || o.Disabled == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Disabled
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *DnsRecord) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetType returns the Type field value if set, zero value otherwise.
+func (o *DnsRecord) GetType() string {
+	if o == nil || o.Type == nil {
+		var ret string
+		return ret
+	}
+	return *o.Type
+}
+
+// GetTtl returns the Ttl field value if set, zero value otherwise.
+func (o *DnsRecord) GetTtl() int32 {
+	if o == nil || o.Ttl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.Ttl
+}
+
+// GetRData returns the RData field value if set, zero value otherwise.
+func (o *DnsRecord) GetRData() DnsRecordRData {
+	if o == nil || o.RData == nil {
+		var ret DnsRecordRData
+		return ret
+	}
+	return *o.RData
+}
+
+// GetDnssecStatus returns the DnssecStatus field value if set, zero value otherwise.
+func (o *DnsRecord) GetDnssecStatus() string {
+	if o == nil || o.DnssecStatus == nil {
+		var ret string
+		return ret
+	}
+	return *o.DnssecStatus
+}
+
+// GetComments returns the Comments field value if set, zero value otherwise.
+func (o *DnsRecord) GetComments() string {
+	if o == nil || o.Comments == nil {
+		var ret string
+		return ret
+	}
+	return *o.Comments
+}
+
+// GetLastUsedOn returns the LastUsedOn field value if set, zero value otherwise.
+func (o *DnsRecord) GetLastUsedOn() string {
+	if o == nil || o.LastUsedOn == nil {
+		var ret string
+		return ret
+	}
+	return *o.LastUsedOn
+}
+
+// GetIpAddress returns the IpAddress field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetIpAddress() string {
+	if o == nil || o.IpAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.IpAddress
+}
+
+// GetNameServer returns the NameServer field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetNameServer() string {
+	if o == nil || o.NameServer == nil {
+		var ret string
+		return ret
+	}
+	return *o.NameServer
+}
+
+// GetCname returns the Cname field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetCname() string {
+	if o == nil || o.Cname == nil {
+		var ret string
+		return ret
+	}
+	return *o.Cname
+}
+
+// GetPtrName returns the PtrName field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetPtrName() string {
+	if o == nil || o.PtrName == nil {
+		var ret string
+		return ret
+	}
+	return *o.PtrName
+}
+
+// GetExchange returns the Exchange field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetExchange() string {
+	if o == nil || o.Exchange == nil {
+		var ret string
+		return ret
+	}
+	return *o.Exchange
+}
+
+// GetPreference returns the Preference field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetPreference() int32 {
+	if o == nil || o.Preference == nil {
+		var ret int32
+		return ret
+	}
+	return *o.Preference
+}
+
+// GetText returns the Text field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetText() string {
+	if o == nil || o.Text == nil {
+		var ret string
+		return ret
+	}
+	return *o.Text
+}
+
+// GetPriority returns the Priority field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetPriority() int32 {
+	if o == nil || o.Priority == nil {
+		var ret int32
+		return ret
+	}
+	return *o.Priority
+}
+
+// GetWeight returns the Weight field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetWeight() int32 {
+	if o == nil || o.Weight == nil {
+		var ret int32
+		return ret
+	}
+	return *o.Weight
+}
+
+// GetPort returns the Port field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetPort() int32 {
+	if o == nil || o.Port == nil {
+		var ret int32
+		return ret
+	}
+	return *o.Port
+}
+
+// GetTarget returns the Target field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetTarget() string {
+	if o == nil || o.Target == nil {
+		var ret string
+		return ret
+	}
+	return *o.Target
+}
+
+// GetValue returns the Value field value if set, zero value otherwise.
+func (o *DnsRecordRData) GetValue() string {
+	if o == nil || o.Value == nil {
+		var ret string
+		return ret
+	}
+	return *o.Value
+}
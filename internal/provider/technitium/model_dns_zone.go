@@ -0,0 +1,317 @@
+package technitium
+
+// ListDnsZonesResponse is returned when listing zones.
+type ListDnsZonesResponse struct {
+	StatusResponse
+	Response *ListDnsZonesResponseBody `json:"response,omitempty"`
+}
+
+// ListDnsZonesResponseBody holds a page of zones.
+type ListDnsZonesResponseBody struct {
+	PageNumber *int32    `json:"pageNumber,omitempty"`
+	TotalPages *int32    `json:"totalPages,omitempty"`
+	TotalZones *int32    `json:"totalZones,omitempty"`
+	Zones      []DnsZone `json:"zones,omitempty"`
+}
+
+// DnsZone describes a zone hosted by the server.
+type DnsZone struct {
+	Name         *string `json:"name,omitempty"`
+	Type         *string `json:"type,omitempty"`
+	Catalog      *string `json:"catalog,omitempty"`
+	Internal     *bool   `json:"internal,omitempty"`
+	DnssecStatus *string `json:"dnssecStatus,omitempty"`
+	SoaSerial    *int64  `json:"soaSerial,omitempty"`
+	Expiry       *string `json:"expiry,omitempty"`
+	IsExpired    *bool   `json:"isExpired,omitempty"`
+	SyncFailed   *bool   `json:"syncFailed,omitempty"`
+	NotifyFailed *bool   `json:"notifyFailed,omitempty"`
+	LastModified *string `json:"lastModified,omitempty"`
+	Disabled     *bool   `json:"disabled,omitempty"`
+}
+
+// CreateDnsZoneResponse is returned when creating a zone.
+type CreateDnsZoneResponse struct {
+	StatusResponse
+	Response *CreateDnsZoneResponseBody `json:"response,omitempty"`
+}
+
+// CreateDnsZoneResponseBody holds the name of the created zone.
+type CreateDnsZoneResponseBody struct {
+	Domain *string `json:"domain,omitempty"`
+}
+
+// DnsZoneOptionsResponse is returned when reading zone options.
+type DnsZoneOptionsResponse struct {
+	StatusResponse
+	Response *DnsZoneOptions `json:"response,omitempty"`
+}
+
+// DnsZoneOptions holds the options of a zone.
+type DnsZoneOptions struct {
+	Name                     *string  `json:"name,omitempty"`
+	Type                     *string  `json:"type,omitempty"`
+	Catalog                  *string  `json:"catalog,omitempty"`
+	Internal                 *bool    `json:"internal,omitempty"`
+	DnssecStatus             *string  `json:"dnssecStatus,omitempty"`
+	Disabled                 *bool    `json:"disabled,omitempty"`
+	ZoneTransfer             *string  `json:"zoneTransfer,omitempty"`
+	ZoneTransferNetworkACL   []string `json:"zoneTransferNetworkACL,omitempty"`
+	ZoneTransferTsigKeyNames []string `json:"zoneTransferTsigKeyNames,omitempty"`
+	Notify                   *string  `json:"notify,omitempty"`
+	NotifyNameServers        []string `json:"notifyNameServers,omitempty"`
+	Update                   *string  `json:"update,omitempty"`
+	UpdateNetworkACL         []string `json:"updateNetworkACL,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *ListDnsZonesResponse) GetResponse() ListDnsZonesResponseBody {
+	if o == nil || o.Response == nil {
+		var ret ListDnsZonesResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetPageNumber returns the PageNumber field value if set, zero value otherwise.
+func (o *ListDnsZonesResponseBody) GetPageNumber() int32 {
+	if o == nil || o.PageNumber == nil {
+		var ret int32
+		return ret
+	}
+	return *o.PageNumber
+}
+
+// GetTotalPages returns the TotalPages field value if set, zero value otherwise.
+func (o *ListDnsZonesResponseBody) GetTotalPages() int32 {
+	if o == nil || o.TotalPages == nil {
+		var ret int32
+		return ret
+	}
+	return *o.TotalPages
+}
+
+// GetTotalZones returns the TotalZones field value if set, zero value otherwise.
+func (o *ListDnsZonesResponseBody) GetTotalZones() int32 {
+	if o == nil || o.TotalZones == nil {
+		var ret int32
+		return ret
+	}
+	return *o.TotalZones
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *DnsZone) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetType returns the Type field value if set, zero value otherwise.
+func (o *DnsZone) GetType() string {
+	if o == nil || o.Type == nil {
+		var ret string
+		return ret
+	}
+	return *o.Type
+}
+
+// GetCatalog returns the Catalog field value if set, zero value otherwise.
+func (o *DnsZone) GetCatalog() string {
+	if o == nil || o.Catalog == nil {
+		var ret string
+		return ret
+	}
+	return *o.Catalog
+}
+
+// GetInternal returns the Internal field value if set, zero value otherwise.
+func (o *DnsZone) GetInternal() bool {
+	if o == nil || o.Internal == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Internal
+}
+
+// GetDnssecStatus returns the DnssecStatus field value if set, zero value otherwise.
+func (o *DnsZone) GetDnssecStatus() string {
+	if o == nil || o.DnssecStatus == nil {
+		var ret string
+		return ret
+	}
+	return *o.DnssecStatus
+}
+
+// GetSoaSerial returns the SoaSerial field value if set, zero value otherwise.
+func (o *DnsZone) GetSoaSerial() int64 {
+	if o == nil || o.SoaSerial == nil {
+		var ret int64
+		return ret
+	}
+	return *o.SoaSerial
+}
+
+// GetExpiry returns the Expiry field value if set, zero value otherwise.
+func (o *DnsZone) GetExpiry() string {
+	if o == nil || o.Expiry == nil {
+		var ret string
+		return ret
+	}
+	return *o.Expiry
+}
+
+// GetIsExpired returns the IsExpired field value if set, zero value otherwise.
+func (o *DnsZone) GetIsExpired() bool {
+	if o == nil || o.IsExpired == nil {
+		var ret bool
+		return ret
+	}
+	return *o.IsExpired
+}
+
+// GetSyncFailed returns the SyncFailed field value if set, zero value otherwise.
+func (o *DnsZone) GetSyncFailed() bool {
+	if o == nil || o.SyncFailed == nil {
+		var ret bool
+		return ret
+	}
+	return *o.SyncFailed
+}
+
+// GetNotifyFailed returns the NotifyFailed field value if set, zero value otherwise.
+func (o *DnsZone) GetNotifyFailed() bool {
+	if o == nil || o.NotifyFailed == nil {
+		var ret bool
+		return ret
+	}
+	return *o.NotifyFailed
+}
+
+// GetLastModified returns the LastModified field value if set, zero value otherwise.
+func (o *DnsZone) GetLastModified() string {
+	if o == nil || o.LastModified == nil {
+		var ret string
+		return ret
+	}
+	return *o.LastModified
+}
+
+// GetDisabled returns the Disabled field value if set, zero value otherwise.
+func (o *DnsZone) GetDisabled() bool {
+	if o == nil || o.Disabled == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Disabled
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *CreateDnsZoneResponse) GetResponse() CreateDnsZoneResponseBody {
+	if o == nil || o.Response == nil {
+		var ret CreateDnsZoneResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetDomain returns the Domain field value if set, zero value otherwise.
+func (o *CreateDnsZoneResponseBody) GetDomain() string {
+	if o == nil || o.Domain == nil {
+		var ret string
+		return ret
+	}
+	return *o.Domain
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *DnsZoneOptionsResponse) GetResponse() DnsZoneOptions {
+	if o == nil || o.Response == nil {
+		var ret DnsZoneOptions
+		return ret
+	}
+	return *o.Response
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *DnsZoneOptions) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetType returns the Type field value if set, zero value otherwise.
+func (o *DnsZoneOptions) GetType() string {
+	if o == nil || o.Type == nil {
+		var ret string
+		return ret
+	}
+	return *o.Type
+}
+
+// GetCatalog returns the Catalog field value if set, zero value otherwise.
+func (o *DnsZoneOptions) GetCatalog() string {
+	if o == nil || o.Catalog == nil {
+		var ret string
+		return ret
+	}
+	return *o.Catalog
+}
+
+// GetInternal returns the Internal field value if set, zero value otherwise.
+func (o *DnsZoneOptions) GetInternal() bool {
+	if o == nil || o.Internal == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Internal
+}
+
+// GetDnssecStatus returns the DnssecStatus field value if set, zero value otherwise.
+func (o *DnsZoneOptions) GetDnssecStatus() string {
+	if o == nil || o.DnssecStatus == nil {
+		var ret string
+		return ret
+	}
+	return *o.DnssecStatus
+}
+
+// GetDisabled returns the Disabled field value if set, zero value otherwise.
+func (o *DnsZoneOptions) GetDisabled() bool {
+	if o == nil || o.Disabled == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Disabled
+}
+
+// GetZoneTransfer returns the ZoneTransfer field value if set, zero value otherwise.
+func (o *DnsZoneOptions) GetZoneTransfer() string {
+	if o == nil || o.ZoneTransfer == nil {
+		var ret string
+		return ret
+	}
+	return *o.ZoneTransfer
+}
+
+// GetNotify returns the Notify field value if set, zero value otherwise.
+func (o *DnsZoneOptions) GetNotify() string {
+	if o == nil || o.Notify == nil {
+		var ret string
+		return ret
+	}
+	return *o.Notify
+}
+
+// GetUpdate returns the Update field value if set, zero value otherwise.
+func (o *DnsZoneOptions) GetUpdate() string {
+	if o == nil || o.Update == nil {
+		var ret string
+		return ret
+	}
+	return *o.Update
+}
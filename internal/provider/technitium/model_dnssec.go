@@ -0,0 +1,254 @@
+package technitium
+
+// DnssecPropertiesResponse is returned when reading the DNSSEC properties of a zone.
+type DnssecPropertiesResponse struct {
+	StatusResponse
+	Response *DnssecProperties `json:"response,omitempty"`
+}
+
+// DnssecProperties describes the signing state and keys of a zone.
+type DnssecProperties struct {
+	Name              *string            `json:"name,omitempty"`
+	Type              *string            `json:"type,omitempty"`
+	Internal          *bool              `json:"internal,omitempty"`
+	Disabled          *bool              `json:"disabled,omitempty"`
+	DnssecStatus      *string            `json:"dnssecStatus,omitempty"`
+	DnsKeyTtl         *int32             `json:"dnsKeyTtl,omitempty"`
+	DnssecPrivateKeys []DnssecPrivateKey `json:"dnssecPrivateKeys,omitempty"`
+}
+
+// DnssecPrivateKey is a key signing or zone signing key of a zone.
+type DnssecPrivateKey struct {
+	KeyTag         *int32  `json:"keyTag,omitempty"`
+	KeyType        *string `json:"keyType,omitempty"`
+	Algorithm      *string `json:"algorithm,omitempty"`
+	State          *string `json:"state,omitempty"`
+	StateChangedOn *string `json:"stateChangedOn,omitempty"`
+	IsRetiring     *bool   `json:"isRetiring,omitempty"`
+	RolloverDays   *int32  `json:"rolloverDays,omitempty"`
+}
+
+// DsInfoResponse is returned when reading the DS records of a signed zone.
+type DsInfoResponse struct {
+	StatusResponse
+	Response *DsInfo `json:"response,omitempty"`
+}
+
+// DsInfo holds the DS records to publish in the parent zone.
+type DsInfo struct {
+	Name      *string    `json:"name,omitempty"`
+	DsRecords []DsRecord `json:"dsRecords,omitempty"`
+}
+
+// DsRecord is the delegation signer data of a key signing key.
+type DsRecord struct {
+	KeyTag      *int32     `json:"keyTag,omitempty"`
+	DnsKeyState *string    `json:"dnsKeyState,omitempty"`
+	Algorithm   *string    `json:"algorithm,omitempty"`
+	PublicKey   *string    `json:"publicKey,omitempty"`
+	Digests     []DsDigest `json:"digests,omitempty"`
+}
+
+// DsDigest is a digest of a key signing key.
+type DsDigest struct {
+	DigestType *string `json:"digestType,omitempty"`
+	Digest     *string `json:"digest,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *DnssecPropertiesResponse) GetResponse() DnssecProperties {
+	if o == nil || o.Response == nil {
+		var ret DnssecProperties
+		return ret
+	}
+	return *o.Response
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *DnssecProperties) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetType returns the Type field value if set, zero value otherwise.
+func (o *DnssecProperties) GetType() string {
+	if o == nil || o.Type == nil {
+		var ret string
+		return ret
+	}
+	return *o.Type
+}
+
+// GetInternal returns the Internal field value if set, zero value otherwise.
+func (o *DnssecProperties) GetInternal() bool {
+	if o == nil || o.Internal == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Internal
+}
+
+// GetDisabled returns the Disabled field value if set, zero value otherwise.
+func (o *DnssecProperties) GetDisabled() bool {
+	if o == nil || o.Disabled == nil {
+		var ret bool
+		return ret
+	}
+	return *o.Disabled
+}
+
+// GetDnssecStatus returns the DnssecStatus field value if set, zero value otherwise.
+func (o *DnssecProperties) GetDnssecStatus() string {
+	if o == nil || o.DnssecStatus == nil {
+		var ret string
+		return ret
+	}
+	return *o.DnssecStatus
+}
+
+// GetDnsKeyTtl returns the DnsKeyTtl field value if set, zero value otherwise.
+func (o *DnssecProperties) GetDnsKeyTtl() int32 {
+	if o == nil || o.DnsKeyTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.DnsKeyTtl
+}
+
+// GetKeyTag returns the KeyTag field value if set, zero value otherwise.
+func (o *DnssecPrivateKey) GetKeyTag() int32 {
+	if o == nil || o.KeyTag == nil {
+		var ret int32
+		return ret
+	}
+	return *o.KeyTag
+}
+
+// GetKeyType returns the KeyType field value if set, zero value otherwise.
+func (o *DnssecPrivateKey) GetKeyType() string {
+	if o == nil || o.KeyType == nil {
+		var ret string
+		return ret
+	}
+	return *o.KeyType
+}
+
+// GetAlgorithm returns the Algorithm field value if set, zero value otherwise.
+func (o *DnssecPrivateKey) GetAlgorithm() string {
+	if o == nil || o.Algorithm == nil {
+		var ret string
+		return ret
+	}
+	return *o.Algorithm
+}
+
+// GetState returns the State field value if set, zero value otherwise.
+func (o *DnssecPrivateKey) GetState() string {
+	if o == nil || o.State == nil {
+		var ret string
+		return ret
+	}
+	return *o.State
+}
+
+// GetStateChangedOn returns the StateChangedOn field value if set, zero value otherwise.
+func (o *DnssecPrivateKey) GetStateChangedOn() string {
+	if o == nil || o.StateChangedOn == nil {
+		var ret string
+		return ret
+	}
+	return *o.StateChangedOn
+}
+
+// GetIsRetiring returns the IsRetiring field value if set, zero value otherwise.
+func (o *DnssecPrivateKey) GetIsRetiring() bool {
+	if o == nil || o.IsRetiring == nil {
+		var ret bool
+		return ret
+	}
+	return *o.IsRetiring
+}
+
+// GetRolloverDays returns the RolloverDays field value if set, zero value otherwise.
+func (o *DnssecPrivateKey) GetRolloverDays() int32 {
+	if o == nil || o.RolloverDays == nil {
+		var ret int32
+		return ret
+	}
+	return *o.RolloverDays
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *DsInfoResponse) GetResponse() DsInfo {
+	if o == nil || o.Response == nil {
+		var ret DsInfo
+		return ret
+	}
+	return *o.Response
+}
+
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *DsInfo) GetName() string {
+	if o == nil || o.Name == nil {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetKeyTag returns the KeyTag field value if set, zero value otherwise.
+func (o *DsRecord) GetKeyTag() int32 {
+	if o == nil || o.KeyTag == nil {
+		var ret int32
+		return ret
+	}
+	return *o.KeyTag
+}
+
+// GetDnsKeyState returns the DnsKeyState field value if set, zero value otherwise.
+func (o *DsRecord) GetDnsKeyState() string {
+	if o == nil || o.DnsKeyState == nil {
+		var ret string
+		return ret
+	}
+	return *o.DnsKeyState
+}
+
+// GetAlgorithm returns the Algorithm field value if set, zero value otherwise.
+func (o *DsRecord) GetAlgorithm() string {
+	if o == nil || o.Algorithm == nil {
+		var ret string
+		return ret
+	}
+	return *o.Algorithm
+}
+
+// GetPublicKey returns the PublicKey field value if set, zero value otherwise.
+func (o *DsRecord) GetPublicKey() string {
+	if o == nil || o.PublicKey == nil {
+		var ret string
+		return ret
+	}
+	return *o.PublicKey
+}
+
+// GetDigestType returns the DigestType field value if set, zero value otherwise.
+func (o *DsDigest) GetDigestType() string {
+	if o == nil || o.DigestType == nil {
+		var ret string
+		return ret
+	}
+	return *o.DigestType
+}
+
+// GetDigest returns the Digest field value if set, zero value otherwise.
+func (o *DsDigest) GetDigest() string {
+	if o == nil || o.Digest == nil {
+		var ret string
+		return ret
+	}
+	return *o.Digest
+}
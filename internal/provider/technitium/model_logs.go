@@ -0,0 +1,199 @@
+package technitium
+
+// ListLogsResponse is returned when listing log files.
+type ListLogsResponse struct {
+	StatusResponse
+	Response *ListLogsResponseBody `json:"response,omitempty"`
+}
+
+// ListLogsResponseBody holds the log files.
+type ListLogsResponseBody struct {
+	LogFiles []LogFile `json:"logFiles,omitempty"`
+}
+
+// LogFile is a daily log file of the server.
+type LogFile struct {
+	FileName *string `json:"fileName,omitempty"`
+	Size     *string `json:"size,omitempty"`
+}
+
+// QueryLogsResponse is returned when querying a query logger app.
+type QueryLogsResponse struct {
+	StatusResponse
+	Response *QueryLogsResponseBody `json:"response,omitempty"`
+}
+
+// QueryLogsResponseBody holds a page of query log entries.
+type QueryLogsResponseBody struct {
+	PageNumber   *int32          `json:"pageNumber,omitempty"`
+	TotalPages   *int32          `json:"totalPages,omitempty"`
+	TotalEntries *int64          `json:"totalEntries,omitempty"`
+	Entries      []QueryLogEntry `json:"entries,omitempty"`
+}
+
+// QueryLogEntry is a single logged query.
+type QueryLogEntry struct {
+	RowNumber       *int64  `json:"rowNumber,omitempty"`
+	Timestamp       *string `json:"timestamp,omitempty"`
+	ClientIpAddress *string `json:"clientIpAddress,omitempty"`
+	Protocol        *string `json:"protocol,omitempty"`
+	ResponseType    *string `json:"responseType,omitempty"`
+	Rcode           *string `json:"rcode,omitempty"`
+	Qname           *string `json:"qname,omitempty"`
+	Qtype           *string `json:"qtype,omitempty"`
+	Qclass          *string `json:"qclass,omitempty"`
+	Answer          *string `json:"answer,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *ListLogsResponse) GetResponse() ListLogsResponseBody {
+	if o == nil || o.Response == nil {
+		var ret ListLogsResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetFileName returns the FileName field value if set, zero value otherwise.
+func (o *LogFile) GetFileName() string {
+	if o == nil || o.FileName == nil {
+		var ret string
+		return ret
+	}
+	return *o.FileName
+}
+
+// GetSize returns the Size field value if set, zero value otherwise.
+func (o *LogFile) GetSize() string {
+	if o == nil || o.Size == nil {
+		var ret string
+		return ret
+	}
+	return *o.Size
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *QueryLogsResponse) GetResponse() QueryLogsResponseBody {
+	if o == nil || o.Response == nil {
+		var ret QueryLogsResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetPageNumber returns the PageNumber field value if set, zero value otherwise.
+func (o *QueryLogsResponseBody) GetPageNumber() int32 {
+	if o == nil || o.PageNumber == nil {
+		var ret int32
+		return ret
+	}
+	return *o.PageNumber
+}
+
+// GetTotalPages returns the TotalPages field value if set, zero value otherwise.
+func (o *QueryLogsResponseBody) GetTotalPages() int32 {
+	if o == nil || o.TotalPages == nil {
+		var ret int32
+		return ret
+	}
+	return *o.TotalPages
+}
+
+// GetTotalEntries returns the TotalEntries field value if set, zero value otherwise.
+func (o *QueryLogsResponseBody) GetTotalEntries() int64 {
+	if o == nil || o.TotalEntries == nil {
+		var ret int64
+		return ret
+	}
+	return *o.TotalEntries
+}
+
+// GetRowNumber returns the RowNumber field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetRowNumber() int64 {
+	if o == nil || o.RowNumber == nil {
+		var ret int64
+		return ret
+	}
+	return *o.RowNumber
+}
+
+// GetTimestamp returns the Timestamp field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetTimestamp() string {
+	if o == nil || o.Timestamp == nil {
+		var ret string
+		return ret
+	}
+	return *o.Timestamp
+}
+
+// GetClientIpAddress returns the ClientIpAddress field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetClientIpAddress() string {
+	if o == nil || o.ClientIpAddress == nil {
+		var ret string
+		return ret
+	}
+	return *o.ClientIpAddress
+}
+
+// GetProtocol returns the Protocol field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetProtocol() string {
+	if o == nil || o.Protocol == nil {
+		var ret string
+		return ret
+	}
+	return *o.Protocol
+}
+
+// GetResponseType returns the ResponseType field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetResponseType() string {
+	if o == nil || o.ResponseType == nil {
+		var ret string
+		return ret
+	}
+	return *o.ResponseType
+}
+
+// GetRcode returns the Rcode field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetRcode() string {
+	if o == nil || o.Rcode == nil {
+		var ret string
+		return ret
+	}
+	return *o.Rcode
+}
+
+// GetQname returns the Qname field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetQname() string {
+	if o == nil || o.Qname == nil {
+		var ret string
+		return ret
+	}
+	return *o.Qname
+}
+
+// GetQtype returns the Qtype field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetQtype() string {
+	if o == nil || o.Qtype == nil {
+		var ret string
+		return ret
+	}
+	return *o.Qtype
+}
+
+// GetQclass returns the Qclass field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetQclass() string {
+	if o == nil || o.Qclass == nil {
+		var ret string
+		return ret
+	}
+	return *o.Qclass
+}
+
+// GetAnswer returns the Answer field value if set, zero value otherwise.
+func (o *QueryLogEntry) GetAnswer() string {
+	if o == nil || o.Answer == nil {
+		var ret string
+		return ret
+	}
+	return *o.Answer
+}
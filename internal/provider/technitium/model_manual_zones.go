@@ -0,0 +1,35 @@
+package technitium
+
+// ListManualZonesResponse is returned when listing the allowed or blocked
+// zone.
+type ListManualZonesResponse struct {
+	StatusResponse
+	Response *ListManualZonesResponseBody `json:"response,omitempty"`
+}
+
+// ListManualZonesResponseBody holds a domain of the allowed or blocked zone.
+// The server descends into a domain that has no records and a single
+// subdomain, so Domain may be below the domain that was listed.
+type ListManualZonesResponseBody struct {
+	Domain  *string     `json:"domain,omitempty"`
+	Zones   []string    `json:"zones,omitempty"`
+	Records []DnsRecord `json:"records,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *ListManualZonesResponse) GetResponse() ListManualZonesResponseBody {
+	if o == nil || o.Response == nil {
+		var ret ListManualZonesResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetDomain returns the Domain field value if set, zero value otherwise.
+func (o *ListManualZonesResponseBody) GetDomain() string {
+	if o == nil || o.Domain == nil {
+		var ret string
+		return ret
+	}
+	return *o.Domain
+}
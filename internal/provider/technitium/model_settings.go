@@ -0,0 +1,802 @@
+package technitium
+
+// DnsSettingsResponse is returned when reading or writing the server settings.
+type DnsSettingsResponse struct {
+	StatusResponse
+	Response *DnsSettings `json:"response,omitempty"`
+}
+
+// DnsSettings holds the server-wide settings. Fields the server does not
+// report, for example because it predates them, are nil.
+type DnsSettings struct {
+	Version                                   *string  `json:"version,omitempty"`
+	Uptimestamp                               *string  `json:"uptimestamp,omitempty"`
+	DnsServerDomain                           *string  `json:"dnsServerDomain,omitempty"`
+	DnsServerLocalEndPoints                   []string `json:"dnsServerLocalEndPoints,omitempty"`
+	DnsServerIPv4SourceAddresses              []string `json:"dnsServerIPv4SourceAddresses,omitempty"`
+	DnsServerIPv6SourceAddresses              []string `json:"dnsServerIPv6SourceAddresses,omitempty"`
+	DefaultRecordTtl                          *int32   `json:"defaultRecordTtl,omitempty"`
+	DefaultResponsiblePerson                  *string  `json:"defaultResponsiblePerson,omitempty"`
+	UseSoaSerialDateScheme                    *bool    `json:"useSoaSerialDateScheme,omitempty"`
+	MinSoaRefresh                             *int32   `json:"minSoaRefresh,omitempty"`
+	MinSoaRetry                               *int32   `json:"minSoaRetry,omitempty"`
+	ZoneTransferAllowedNetworks               []string `json:"zoneTransferAllowedNetworks,omitempty"`
+	NotifyAllowedNetworks                     []string `json:"notifyAllowedNetworks,omitempty"`
+	DnsAppsEnableAutomaticUpdate              *bool    `json:"dnsAppsEnableAutomaticUpdate,omitempty"`
+	PreferIPv6                                *bool    `json:"preferIPv6,omitempty"`
+	EnableUdpSocketPool                       *bool    `json:"enableUdpSocketPool,omitempty"`
+	UdpPayloadSize                            *int32   `json:"udpPayloadSize,omitempty"`
+	DnssecValidation                          *bool    `json:"dnssecValidation,omitempty"`
+	EDnsClientSubnet                          *bool    `json:"eDnsClientSubnet,omitempty"`
+	EDnsClientSubnetIPv4PrefixLength          *int32   `json:"eDnsClientSubnetIPv4PrefixLength,omitempty"`
+	EDnsClientSubnetIPv6PrefixLength          *int32   `json:"eDnsClientSubnetIPv6PrefixLength,omitempty"`
+	QpmLimitRequests                          *int32   `json:"qpmLimitRequests,omitempty"`
+	QpmLimitErrors                            *int32   `json:"qpmLimitErrors,omitempty"`
+	QpmLimitSampleMinutes                     *int32   `json:"qpmLimitSampleMinutes,omitempty"`
+	QpmLimitIPv4PrefixLength                  *int32   `json:"qpmLimitIPv4PrefixLength,omitempty"`
+	QpmLimitIPv6PrefixLength                  *int32   `json:"qpmLimitIPv6PrefixLength,omitempty"`
+	QpmLimitBypassList                        []string `json:"qpmLimitBypassList,omitempty"`
+	ClientTimeout                             *int32   `json:"clientTimeout,omitempty"`
+	TcpSendTimeout                            *int32   `json:"tcpSendTimeout,omitempty"`
+	TcpReceiveTimeout                         *int32   `json:"tcpReceiveTimeout,omitempty"`
+	ListenBacklog                             *int32   `json:"listenBacklog,omitempty"`
+	MaxConcurrentResolutionsPerCore           *int32   `json:"maxConcurrentResolutionsPerCore,omitempty"`
+	WebServiceLocalAddresses                  []string `json:"webServiceLocalAddresses,omitempty"`
+	WebServiceHttpPort                        *int32   `json:"webServiceHttpPort,omitempty"`
+	WebServiceEnableTls                       *bool    `json:"webServiceEnableTls,omitempty"`
+	WebServiceTlsPort                         *int32   `json:"webServiceTlsPort,omitempty"`
+	EnableDnsOverHttp                         *bool    `json:"enableDnsOverHttp,omitempty"`
+	EnableDnsOverTls                          *bool    `json:"enableDnsOverTls,omitempty"`
+	EnableDnsOverHttps                        *bool    `json:"enableDnsOverHttps,omitempty"`
+	EnableDnsOverQuic                         *bool    `json:"enableDnsOverQuic,omitempty"`
+	Recursion                                 *string  `json:"recursion,omitempty"`
+	RecursionNetworkACL                       []string `json:"recursionNetworkACL,omitempty"`
+	RandomizeName                             *bool    `json:"randomizeName,omitempty"`
+	QnameMinimization                         *bool    `json:"qnameMinimization,omitempty"`
+	NsRevalidation                            *bool    `json:"nsRevalidation,omitempty"`
+	ResolverRetries                           *int32   `json:"resolverRetries,omitempty"`
+	ResolverTimeout                           *int32   `json:"resolverTimeout,omitempty"`
+	ResolverConcurrency                       *int32   `json:"resolverConcurrency,omitempty"`
+	ResolverMaxStackCount                     *int32   `json:"resolverMaxStackCount,omitempty"`
+	SaveCache                                 *bool    `json:"saveCache,omitempty"`
+	ServeStale                                *bool    `json:"serveStale,omitempty"`
+	ServeStaleTtl                             *int32   `json:"serveStaleTtl,omitempty"`
+	ServeStaleAnswerTtl                       *int32   `json:"serveStaleAnswerTtl,omitempty"`
+	ServeStaleResetTtl                        *int32   `json:"serveStaleResetTtl,omitempty"`
+	ServeStaleMaxWaitTime                     *int32   `json:"serveStaleMaxWaitTime,omitempty"`
+	CacheMaximumEntries                       *int64   `json:"cacheMaximumEntries,omitempty"`
+	CacheMinimumRecordTtl                     *int32   `json:"cacheMinimumRecordTtl,omitempty"`
+	CacheMaximumRecordTtl                     *int32   `json:"cacheMaximumRecordTtl,omitempty"`
+	CacheNegativeRecordTtl                    *int32   `json:"cacheNegativeRecordTtl,omitempty"`
+	CacheFailureRecordTtl                     *int32   `json:"cacheFailureRecordTtl,omitempty"`
+	CachePrefetchEligibility                  *int32   `json:"cachePrefetchEligibility,omitempty"`
+	CachePrefetchTrigger                      *int32   `json:"cachePrefetchTrigger,omitempty"`
+	CachePrefetchSampleIntervalInMinutes      *int32   `json:"cachePrefetchSampleIntervalInMinutes,omitempty"`
+	CachePrefetchSampleEligibilityHitsPerHour *int32   `json:"cachePrefetchSampleEligibilityHitsPerHour,omitempty"`
+	EnableBlocking                            *bool    `json:"enableBlocking,omitempty"`
+	AllowTxtBlockingReport                    *bool    `json:"allowTxtBlockingReport,omitempty"`
+	BlockingBypassList                        []string `json:"blockingBypassList,omitempty"`
+	BlockingType                              *string  `json:"blockingType,omitempty"`
+	BlockingAnswerTtl                         *int32   `json:"blockingAnswerTtl,omitempty"`
+	CustomBlockingAddresses                   []string `json:"customBlockingAddresses,omitempty"`
+	BlockListUrls                             []string `json:"blockListUrls,omitempty"`
+	BlockListUrlUpdateIntervalHours           *int32   `json:"blockListUrlUpdateIntervalHours,omitempty"`
+	BlockListNextUpdatedOn                    *string  `json:"blockListNextUpdatedOn,omitempty"`
+	TemporaryDisableBlockingTill              *string  `json:"temporaryDisableBlockingTill,omitempty"`
+	Forwarders                                []string `json:"forwarders,omitempty"`
+	ForwarderProtocol                         *string  `json:"forwarderProtocol,omitempty"`
+	ConcurrentForwarding                      *bool    `json:"concurrentForwarding,omitempty"`
+	ForwarderRetries                          *int32   `json:"forwarderRetries,omitempty"`
+	ForwarderTimeout                          *int32   `json:"forwarderTimeout,omitempty"`
+	ForwarderConcurrency                      *int32   `json:"forwarderConcurrency,omitempty"`
+	EnableLogging                             *bool    `json:"enableLogging,omitempty"`
+	LogQueries                                *bool    `json:"logQueries,omitempty"`
+	UseLocalTime                              *bool    `json:"useLocalTime,omitempty"`
+	MaxLogFileDays                            *int32   `json:"maxLogFileDays,omitempty"`
+	EnableInMemoryStats                       *bool    `json:"enableInMemoryStats,omitempty"`
+	MaxStatFileDays                           *int32   `json:"maxStatFileDays,omitempty"`
+}
+
+// TemporaryDisableBlockingResponse is returned when blocking is temporarily disabled.
+type TemporaryDisableBlockingResponse struct {
+	StatusResponse
+	Response *TemporaryDisableBlockingResponseBody `json:"response,omitempty"`
+}
+
+// TemporaryDisableBlockingResponseBody holds the time blocking is re-enabled.
+type TemporaryDisableBlockingResponseBody struct {
+	TemporaryDisableBlockingTill *string `json:"temporaryDisableBlockingTill,omitempty"`
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *DnsSettingsResponse) GetResponse() DnsSettings {
+	if o == nil || o.Response == nil {
+		var ret DnsSettings
+		return ret
+	}
+	return *o.Response
+}
+
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *DnsSettings) GetVersion() string {
+	if o == nil || o.Version == nil {
+		var ret string
+		return ret
+	}
+	return *o.Version
+}
+
+// GetUptimestamp returns the Uptimestamp field value if set, zero value otherwise.
+func (o *DnsSettings) GetUptimestamp() string {
+	if o == nil || o.Uptimestamp == nil {
+		var ret string
+		return ret
+	}
+	return *o.Uptimestamp
+}
+
+// GetDnsServerDomain returns the DnsServerDomain field value if set, zero value otherwise.
+func (o *DnsSettings) GetDnsServerDomain() string {
+	if o == nil || o.DnsServerDomain == nil {
+		var ret string
+		return ret
+	}
+	return *o.DnsServerDomain
+}
+
+// GetDefaultRecordTtl returns the DefaultRecordTtl field value if set, zero value otherwise.
+func (o *DnsSettings) GetDefaultRecordTtl() int32 {
+	if o == nil || o.DefaultRecordTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.DefaultRecordTtl
+}
+
+// GetDefaultResponsiblePerson returns the DefaultResponsiblePerson field value if set, zero value otherwise.
+func (o *DnsSettings) GetDefaultResponsiblePerson() string {
+	if o == nil || o.DefaultResponsiblePerson == nil {
+		var ret string
+		return ret
+	}
+	return *o.DefaultResponsiblePerson
+}
+
+// GetUseSoaSerialDateScheme returns the UseSoaSerialDateScheme field value if set, zero value otherwise.
+func (o *DnsSettings) GetUseSoaSerialDateScheme() bool {
+	if o == nil || o.UseSoaSerialDateScheme == nil {
+		var ret bool
+		return ret
+	}
+	return *o.UseSoaSerialDateScheme
+}
+
+// GetMinSoaRefresh returns the MinSoaRefresh field value if set, zero value otherwise.
+func (o *DnsSettings) GetMinSoaRefresh() int32 {
+	if o == nil || o.MinSoaRefresh == nil {
+		var ret int32
+		return ret
+	}
+	return *o.MinSoaRefresh
+}
+
+// GetMinSoaRetry returns the MinSoaRetry field value if set, zero value otherwise.
+func (o *DnsSettings) GetMinSoaRetry() int32 {
+	if o == nil || o.MinSoaRetry == nil {
+		var ret int32
+		return ret
+	}
+	return *o.MinSoaRetry
+}
+
+// GetDnsAppsEnableAutomaticUpdate returns the DnsAppsEnableAutomaticUpdate field value if set, zero value otherwise.
+func (o *DnsSettings) GetDnsAppsEnableAutomaticUpdate() bool {
+	if o == nil || o.DnsAppsEnableAutomaticUpdate == nil {
+		var ret bool
+		return ret
+	}
+	return *o.DnsAppsEnableAutomaticUpdate
+}
+
+// GetPreferIPv6 returns the PreferIPv6 field value if set, zero value otherwise.
+func (o *DnsSettings) GetPreferIPv6() bool {
+	if o == nil || o.PreferIPv6 == nil {
+		var ret bool
+		return ret
+	}
+	return *o.PreferIPv6
+}
+
+// GetEnableUdpSocketPool returns the EnableUdpSocketPool field value if set, zero value otherwise.
+func (o *DnsSettings) GetEnableUdpSocketPool() bool {
+	if o == nil || o.EnableUdpSocketPool == nil {
+		var ret bool
+		return ret
+	}
+	return *o.EnableUdpSocketPool
+}
+
+// GetUdpPayloadSize returns the UdpPayloadSize field value if set, zero value otherwise.
+func (o *DnsSettings) GetUdpPayloadSize() int32 {
+	if o == nil || o.UdpPayloadSize == nil {
+		var ret int32
+		return ret
+	}
+	return *o.UdpPayloadSize
+}
+
+// GetDnssecValidation returns the DnssecValidation field value if set, zero value otherwise.
+func (o *DnsSettings) GetDnssecValidation() bool {
+	if o == nil || o.DnssecValidation == nil {
+		var ret bool
+		return ret
+	}
+	return *o.DnssecValidation
+}
+
+// GetEDnsClientSubnet returns the EDnsClientSubnet field value if set, zero value otherwise.
+func (o *DnsSettings) GetEDnsClientSubnet() bool {
+	if o == nil || o.EDnsClientSubnet == nil {
+		var ret bool
+		return ret
+	}
+	return *o.EDnsClientSubnet
+}
+
+// GetEDnsClientSubnetIPv4PrefixLength returns the EDnsClientSubnetIPv4PrefixLength field value if set, zero value otherwise.
+func (o *DnsSettings) GetEDnsClientSubnetIPv4PrefixLength() int32 {
+	if o == nil || o.EDnsClientSubnetIPv4PrefixLength == nil {
+		var ret int32
+		return ret
+	}
+	return *o.EDnsClientSubnetIPv4PrefixLength
+}
+
+// GetEDnsClientSubnetIPv6PrefixLength returns the EDnsClientSubnetIPv6PrefixLength field value if set, zero value otherwise.
+func (o *DnsSettings) GetEDnsClientSubnetIPv6PrefixLength() int32 {
+	if o == nil || o.EDnsClientSubnetIPv6PrefixLength == nil {
+		var ret int32
+		return ret
+	}
+	return *o.EDnsClientSubnetIPv6PrefixLength
+}
+
+// GetQpmLimitRequests returns the QpmLimitRequests field value if set, zero value otherwise.
+func (o *DnsSettings) GetQpmLimitRequests() int32 {
+	if o == nil || o.QpmLimitRequests == nil {
+		var ret int32
+		return ret
+	}
+	return *o.QpmLimitRequests
+}
+
+// GetQpmLimitErrors returns the QpmLimitErrors field value if set, zero value otherwise.
+func (o *DnsSettings) GetQpmLimitErrors() int32 {
+	if o == nil || o.QpmLimitErrors == nil {
+		var ret int32
+		return ret
+	}
+	return *o.QpmLimitErrors
+}
+
+// GetQpmLimitSampleMinutes returns the QpmLimitSampleMinutes field value if set, zero value otherwise.
+func (o *DnsSettings) GetQpmLimitSampleMinutes() int32 {
+	if o == nil || o.QpmLimitSampleMinutes == nil {
+		var ret int32
+		return ret
+	}
+	return *o.QpmLimitSampleMinutes
+}
+
+// GetQpmLimitIPv4PrefixLength returns the QpmLimitIPv4PrefixLength field value if set, zero value otherwise.
+func (o *DnsSettings) GetQpmLimitIPv4PrefixLength() int32 {
+	if o == nil || o.QpmLimitIPv4PrefixLength == nil {
+		var ret int32
+		return ret
+	}
+	return *o.QpmLimitIPv4PrefixLength
+}
+
+// GetQpmLimitIPv6PrefixLength returns the QpmLimitIPv6PrefixLength field value if set, zero value otherwise.
+func (o *DnsSettings) GetQpmLimitIPv6PrefixLength() int32 {
+	if o == nil || o.QpmLimitIPv6PrefixLength == nil {
+		var ret int32
+		return ret
+	}
+	return *o.QpmLimitIPv6PrefixLength
+}
+
+// GetClientTimeout returns the ClientTimeout field value if set, zero value otherwise.
+func (o *DnsSettings) GetClientTimeout() int32 {
+	if o == nil || o.ClientTimeout == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ClientTimeout
+}
+
+// GetTcpSendTimeout returns the TcpSendTimeout field value if set, zero value otherwise.
+func (o *DnsSettings) GetTcpSendTimeout() int32 {
+	if o == nil || o.TcpSendTimeout == nil {
+		var ret int32
+		return ret
+	}
+	return *o.TcpSendTimeout
+}
+
+// GetTcpReceiveTimeout returns the TcpReceiveTimeout field value if set, zero value otherwise.
+func (o *DnsSettings) GetTcpReceiveTimeout() int32 {
+	if o == nil || o.TcpReceiveTimeout == nil {
+		var ret int32
+		return ret
+	}
+	return *o.TcpReceiveTimeout
+}
+
+// GetListenBacklog returns the ListenBacklog field value if set, zero value otherwise.
+func (o *DnsSettings) GetListenBacklog() int32 {
+	if o == nil || o.ListenBacklog == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ListenBacklog
+}
+
+// GetMaxConcurrentResolutionsPerCore returns the MaxConcurrentResolutionsPerCore field value if set, zero value otherwise.
+func (o *DnsSettings) GetMaxConcurrentResolutionsPerCore() int32 {
+	if o == nil || o.MaxConcurrentResolutionsPerCore == nil {
+		var ret int32
+		return ret
+	}
+	return *o.MaxConcurrentResolutionsPerCore
+}
+
+// GetWebServiceHttpPort returns the WebServiceHttpPort field value if set, zero value otherwise.
+func (o *DnsSettings) GetWebServiceHttpPort() int32 {
+	if o == nil || o.WebServiceHttpPort == nil {
+		var ret int32
+		return ret
+	}
+	return *o.WebServiceHttpPort
+}
+
+// GetWebServiceEnableTls returns the WebServiceEnableTls field value if set, zero value otherwise.
+func (o *DnsSettings) GetWebServiceEnableTls() bool {
+	if o == nil || o.WebServiceEnableTls == nil {
+		var ret bool
+		return ret
+	}
+	return *o.WebServiceEnableTls
+}
+
+// GetWebServiceTlsPort returns the WebServiceTlsPort field value if set, zero value otherwise.
+func (o *DnsSettings) GetWebServiceTlsPort() int32 {
+	if o == nil || o.WebServiceTlsPort == nil {
+		var ret int32
+		return ret
+	}
+	return *o.WebServiceTlsPort
+}
+
+// GetEnableDnsOverHttp returns the EnableDnsOverHttp field value if set, zero value otherwise.
+func (o *DnsSettings) GetEnableDnsOverHttp() bool {
+	if o == nil || o.EnableDnsOverHttp == nil {
+		var ret bool
+		return ret
+	}
+	return *o.EnableDnsOverHttp
+}
+
+// GetEnableDnsOverTls returns the EnableDnsOverTls field value if set, zero value otherwise.
+func (o *DnsSettings) GetEnableDnsOverTls() bool {
+	if o == nil || o.EnableDnsOverTls == nil {
+		var ret bool
+		return ret
+	}
+	return *o.EnableDnsOverTls
+}
+
+// GetEnableDnsOverHttps returns the EnableDnsOverHttps field value if set, zero value otherwise.
+func (o *DnsSettings) GetEnableDnsOverHttps() bool {
+	if o == nil || o.EnableDnsOverHttps == nil {
+		var ret bool
+		return ret
+	}
+	return *o.EnableDnsOverHttps
+}
+
+// GetEnableDnsOverQuic returns the EnableDnsOverQuic field value if set, zero value otherwise.
+func (o *DnsSettings) GetEnableDnsOverQuic() bool {
+	if o == nil || o.EnableDnsOverQuic == nil {
+		var ret bool
+		return ret
+	}
+	return *o.EnableDnsOverQuic
+}
+
+// GetRecursion returns the Recursion field value if set, zero value otherwise.
+func (o *DnsSettings) GetRecursion() string {
+	if o == nil || o.Recursion == nil {
+		var ret string
+		return ret
+	}
+	return *o.Recursion
+}
+
+// GetRandomizeName returns the RandomizeName field value if set, zero value otherwise.
+func (o *DnsSettings) GetRandomizeName() bool {
+	if o == nil || o.RandomizeName == nil {
+		var ret bool
+		return ret
+	}
+	return *o.RandomizeName
+}
+
+// GetQnameMinimization returns the QnameMinimization field value if set, zero value otherwise.
+func (o *DnsSettings) GetQnameMinimization() bool {
+	if o == nil || o.QnameMinimization == nil {
+		var ret bool
+		return ret
+	}
+	return *o.QnameMinimization
+}
+
+// GetNsRevalidation returns the NsRevalidation field value if set, zero value otherwise.
+func (o *DnsSettings) GetNsRevalidation() bool {
+	if o == nil || o.NsRevalidation == nil {
+		var ret bool
+		return ret
+	}
+	return *o.NsRevalidation
+}
+
+// GetResolverRetries returns the ResolverRetries field value if set, zero value otherwise.
+func (o *DnsSettings) GetResolverRetries() int32 {
+	if o == nil || o.ResolverRetries == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ResolverRetries
+}
+
+// GetResolverTimeout returns the ResolverTimeout field value if set, zero value otherwise.
+func (o *DnsSettings) GetResolverTimeout() int32 {
+	if o == nil || o.ResolverTimeout == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ResolverTimeout
+}
+
+// GetResolverConcurrency returns the ResolverConcurrency field value if set, zero value otherwise.
+func (o *DnsSettings) GetResolverConcurrency() int32 {
+	if o == nil || o.ResolverConcurrency == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ResolverConcurrency
+}
+
+// GetResolverMaxStackCount returns the ResolverMaxStackCount field value if set, zero value otherwise.
+func (o *DnsSettings) GetResolverMaxStackCount() int32 {
+	if o == nil || o.ResolverMaxStackCount == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ResolverMaxStackCount
+}
+
+// GetSaveCache returns the SaveCache field value if set, zero value otherwise.
+func (o *DnsSettings) GetSaveCache() bool {
+	if o == nil || o.SaveCache == nil {
+		var ret bool
+		return ret
+	}
+	return *o.SaveCache
+}
+
+// GetServeStale returns the ServeStale field value if set, zero value otherwise.
+func (o *DnsSettings) GetServeStale() bool {
+	if o == nil || o.ServeStale == nil {
+		var ret bool
+		return ret
+	}
+	return *o.ServeStale
+}
+
+// GetServeStaleTtl returns the ServeStaleTtl field value if set, zero value otherwise.
+func (o *DnsSettings) GetServeStaleTtl() int32 {
+	if o == nil || o.ServeStaleTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ServeStaleTtl
+}
+
+// GetServeStaleAnswerTtl returns the ServeStaleAnswerTtl field value if set, zero value otherwise.
+func (o *DnsSettings) GetServeStaleAnswerTtl() int32 {
+	if o == nil || o.ServeStaleAnswerTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ServeStaleAnswerTtl
+}
+
+// GetServeStaleResetTtl returns the ServeStaleResetTtl field value if set, zero value otherwise.
+func (o *DnsSettings) GetServeStaleResetTtl() int32 {
+	if o == nil || o.ServeStaleResetTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ServeStaleResetTtl
+}
+
+// GetServeStaleMaxWaitTime returns the ServeStaleMaxWaitTime field value if set, zero value otherwise.
+func (o *DnsSettings) GetServeStaleMaxWaitTime() int32 {
+	if o == nil || o.ServeStaleMaxWaitTime == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ServeStaleMaxWaitTime
+}
+
+// GetCacheMaximumEntries returns the CacheMaximumEntries field value if set, zero value otherwise.
+func (o *DnsSettings) GetCacheMaximumEntries() int64 {
+	if o == nil || o.CacheMaximumEntries == nil {
+		var ret int64
+		return ret
+	}
+	return *o.CacheMaximumEntries
+}
+
+// GetCacheMinimumRecordTtl returns the CacheMinimumRecordTtl field value if set, zero value otherwise.
+func (o *DnsSettings) GetCacheMinimumRecordTtl() int32 {
+	if o == nil || o.CacheMinimumRecordTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.CacheMinimumRecordTtl
+}
+
+// GetCacheMaximumRecordTtl returns the CacheMaximumRecordTtl field value if set, zero value otherwise.
+func (o *DnsSettings) GetCacheMaximumRecordTtl() int32 {
+	if o == nil || o.CacheMaximumRecordTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.CacheMaximumRecordTtl
+}
+
+// GetCacheNegativeRecordTtl returns the CacheNegativeRecordTtl field value if set, zero value otherwise.
+func (o *DnsSettings) GetCacheNegativeRecordTtl() int32 {
+	if o == nil || o.CacheNegativeRecordTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.CacheNegativeRecordTtl
+}
+
+// GetCacheFailureRecordTtl returns the CacheFailureRecordTtl field value if set, zero value otherwise.
+func (o *DnsSettings) GetCacheFailureRecordTtl() int32 {
+	if o == nil || o.CacheFailureRecordTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.CacheFailureRecordTtl
+}
+
+// GetCachePrefetchEligibility returns the CachePrefetchEligibility field value if set, zero value otherwise.
+func (o *DnsSettings) GetCachePrefetchEligibility() int32 {
+	if o == nil || o.CachePrefetchEligibility == nil {
+		var ret int32
+		return ret
+	}
+	return *o.CachePrefetchEligibility
+}
+
+// GetCachePrefetchTrigger returns the CachePrefetchTrigger field value if set, zero value otherwise.
+func (o *DnsSettings) GetCachePrefetchTrigger() int32 {
+	if o == nil || o.CachePrefetchTrigger == nil {
+		var ret int32
+		return ret
+	}
+	return *o.CachePrefetchTrigger
+}
+
+// GetCachePrefetchSampleIntervalInMinutes returns the CachePrefetchSampleIntervalInMinutes field value if set, zero value otherwise.
+func (o *DnsSettings) GetCachePrefetchSampleIntervalInMinutes() int32 {
+	if o == nil || o.CachePrefetchSampleIntervalInMinutes == nil {
+		var ret int32
+		return ret
+	}
+	return *o.CachePrefetchSampleIntervalInMinutes
+}
+
+// GetCachePrefetchSampleEligibilityHitsPerHour returns the CachePrefetchSampleEligibilityHitsPerHour field value if set, zero value otherwise.
+func (o *DnsSettings) GetCachePrefetchSampleEligibilityHitsPerHour() int32 {
+	if o == nil || o.CachePrefetchSampleEligibilityHitsPerHour == nil {
+		var ret int32
+		return ret
+	}
+	return *o.CachePrefetchSampleEligibilityHitsPerHour
+}
+
+// GetEnableBlocking returns the EnableBlocking field value if set, zero value otherwise.
+func (o *DnsSettings) GetEnableBlocking() bool {
+	if o == nil || o.EnableBlocking == nil {
+		var ret bool
+		return ret
+	}
+	return *o.EnableBlocking
+}
+
+// GetAllowTxtBlockingReport returns the AllowTxtBlockingReport field value if set, zero value otherwise.
+func (o *DnsSettings) GetAllowTxtBlockingReport() bool {
+	if o == nil || o.AllowTxtBlockingReport == nil {
+		var ret bool
+		return ret
+	}
+	return *o.AllowTxtBlockingReport
+}
+
+// GetBlockingType returns the BlockingType field value if set, zero value otherwise.
+func (o *DnsSettings) GetBlockingType() string {
+	if o == nil || o.BlockingType == nil {
+		var ret string
+		return ret
+	}
+	return *o.BlockingType
+}
+
+// GetBlockingAnswerTtl returns the BlockingAnswerTtl field value if set, zero value otherwise.
+func (o *DnsSettings) GetBlockingAnswerTtl() int32 {
+	if o == nil || o.BlockingAnswerTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.BlockingAnswerTtl
+}
+
+// GetBlockListUrlUpdateIntervalHours returns the BlockListUrlUpdateIntervalHours field value if set, zero value otherwise.
+func (o *DnsSettings) GetBlockListUrlUpdateIntervalHours() int32 {
+	if o == nil || o.BlockListUrlUpdateIntervalHours == nil {
+		var ret int32
+		return ret
+	}
+	return *o.BlockListUrlUpdateIntervalHours
+}
+
+// GetBlockListNextUpdatedOn returns the BlockListNextUpdatedOn field value if set, zero value otherwise.
+func (o *DnsSettings) GetBlockListNextUpdatedOn() string {
+	if o == nil || o.BlockListNextUpdatedOn == nil {
+		var ret string
+		return ret
+	}
+	return *o.BlockListNextUpdatedOn
+}
+
+// GetTemporaryDisableBlockingTill returns the TemporaryDisableBlockingTill field value if set, zero value otherwise.
+func (o *DnsSettings) GetTemporaryDisableBlockingTill() string {
+	if o == nil || o.TemporaryDisableBlockingTill == nil {
+		var ret string
+		return ret
+	}
+	return *o.TemporaryDisableBlockingTill
+}
+
+// GetForwarderProtocol returns the ForwarderProtocol field value if set, zero value otherwise.
+func (o *DnsSettings) GetForwarderProtocol() string {
+	if o == nil || o.ForwarderProtocol == nil {
+		var ret string
+		return ret
+	}
+	return *o.ForwarderProtocol
+}
+
+// GetConcurrentForwarding returns the ConcurrentForwarding field value if set, zero value otherwise.
+func (o *DnsSettings) GetConcurrentForwarding() bool {
+	if o == nil || o.ConcurrentForwarding == nil {
+		var ret bool
+		return ret
+	}
+	return *o.ConcurrentForwarding
+}
+
+// GetForwarderRetries returns the ForwarderRetries field value if set, zero value otherwise.
+func (o *DnsSettings) GetForwarderRetries() int32 {
+	if o == nil || o.ForwarderRetries == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ForwarderRetries
+}
+
+// GetForwarderTimeout returns the ForwarderTimeout field value if set, zero value otherwise.
+func (o *DnsSettings) GetForwarderTimeout() int32 {
+	if o == nil || o.ForwarderTimeout == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ForwarderTimeout
+}
+
+// GetForwarderConcurrency returns the ForwarderConcurrency field value if set, zero value otherwise.
+func (o *DnsSettings) GetForwarderConcurrency() int32 {
+	if o == nil || o.ForwarderConcurrency == nil {
+		var ret int32
+		return ret
+	}
+	return *o.ForwarderConcurrency
+}
+
+// GetEnableLogging returns the EnableLogging field value if set, zero value otherwise.
+func (o *DnsSettings) GetEnableLogging() bool {
+	if o == nil || o.EnableLogging == nil {
+		var ret bool
+		return ret
+	}
+	return *o.EnableLogging
+}
+
+// GetLogQueries returns the LogQueries field value if set, zero value otherwise.
+func (o *DnsSettings) GetLogQueries() bool {
+	if o == nil || o.LogQueries == nil {
+		var ret bool
+		return ret
+	}
+	return *o.LogQueries
+}
+
+// GetUseLocalTime returns the UseLocalTime field value if set, zero value otherwise.
+func (o *DnsSettings) GetUseLocalTime() bool {
+	if o == nil || o.UseLocalTime == nil {
+		var ret bool
+		return ret
+	}
+	return *o.UseLocalTime
+}
+
+// GetMaxLogFileDays returns the MaxLogFileDays field value if set, zero value otherwise.
+func (o *DnsSettings) GetMaxLogFileDays() int32 {
+	if o == nil || o.MaxLogFileDays == nil {
+		var ret int32
+		return ret
+	}
+	return *o.MaxLogFileDays
+}
+
+// GetEnableInMemoryStats returns the EnableInMemoryStats field value if set, zero value otherwise.
+func (o *DnsSettings) GetEnableInMemoryStats() bool {
+	if o == nil || o.EnableInMemoryStats == nil {
+		var ret bool
+		return ret
+	}
+	return *o.EnableInMemoryStats
+}
+
+// GetMaxStatFileDays returns the MaxStatFileDays field value if set, zero value otherwise.
+func (o *DnsSettings) GetMaxStatFileDays() int32 {
+	if o == nil || o.MaxStatFileDays == nil {
+		var ret int32
+		return ret
+	}
+	return *o.MaxStatFileDays
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *TemporaryDisableBlockingResponse) GetResponse() TemporaryDisableBlockingResponseBody {
+	if o == nil || o.Response == nil {
+		var ret TemporaryDisableBlockingResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetTemporaryDisableBlockingTill returns the TemporaryDisableBlockingTill field value if set, zero value otherwise.
+func (o *TemporaryDisableBlockingResponseBody) GetTemporaryDisableBlockingTill() string {
+	if o == nil || o.TemporaryDisableBlockingTill == nil {
+		var ret string
+		return ret
+	}
+	return *o.TemporaryDisableBlockingTill
+}
@@ -0,0 +1,47 @@
+package technitium
+
+// StatusResponse is the envelope shared by every API response. Status is
+// "ok" on success, "error" with ErrorMessage set when the call failed, and
+// "invalid-token" when the session or API token is not accepted.
+type StatusResponse struct {
+	Status            *string `json:"status,omitempty"`
+	ErrorMessage      *string `json:"errorMessage,omitempty"`
+	StackTrace        *string `json:"stackTrace,omitempty"`
+	InnerErrorMessage *string `json:"innerErrorMessage,omitempty"`
+}
+
+// GetStatus returns the Status field value if set, zero value otherwise.
+func (o *StatusResponse) GetStatus() string {
+	if o == nil || o.Status == nil {
+		var ret string
+		return ret
+	}
+	return *o.Status
+}
+
+// GetErrorMessage returns the ErrorMessage field value if set, zero value otherwise.
+func (o *StatusResponse) GetErrorMessage() string {
+	if o == nil || o.ErrorMessage == nil {
+		var ret string
+		return ret
+	}
+	return *o.ErrorMessage
+}
+
+// GetStackTrace returns the StackTrace field value if set, zero value otherwise.
+func (o *StatusResponse) GetStackTrace() string {
+	if o == nil || o.StackTrace == nil {
+		var ret string
+		return ret
+	}
+	return *o.StackTrace
+}
+
+// GetInnerErrorMessage returns the InnerErrorMessage field value if set, zero value otherwise.
+func (o *StatusResponse) GetInnerErrorMessage() string {
+	if o == nil || o.InnerErrorMessage == nil {
+		var ret string
+		return ret
+	}
+	return *o.InnerErrorMessage
+}
@@ -0,0 +1,229 @@
+package technitium
+
+// LoginResponse is returned by the login and session calls.
+type LoginResponse struct {
+	StatusResponse
+	DisplayName *string      `json:"displayName,omitempty"`
+	Username    *string      `json:"username,omitempty"`
+	Token       *string      `json:"token,omitempty"`
+	Info        *SessionInfo `json:"info,omitempty"`
+}
+
+// SessionInfo describes the server and the permissions of the session user.
+type SessionInfo struct {
+	Version          *string                      `json:"version,omitempty"`
+	Uptimestamp      *string                      `json:"uptimestamp,omitempty"`
+	DnsServerDomain  *string                      `json:"dnsServerDomain,omitempty"`
+	DefaultRecordTtl *int32                       `json:"defaultRecordTtl,omitempty"`
+	Permissions      map[string]SessionPermission `json:"permissions,omitempty"`
+}
+
+// SessionPermission lists the access the session user has to a section.
+type SessionPermission struct {
+	CanView   *bool `json:"canView,omitempty"`
+	CanModify *bool `json:"canModify,omitempty"`
+	CanDelete *bool `json:"canDelete,omitempty"`
+}
+
+// CreateTokenResponse is returned when creating a non-expiring API token.
+type CreateTokenResponse struct {
+	StatusResponse
+	Username  *string `json:"username,omitempty"`
+	TokenName *string `json:"tokenName,omitempty"`
+	Token     *string `json:"token,omitempty"`
+}
+
+// CheckForUpdateResponse reports the running and latest available versions.
+type CheckForUpdateResponse struct {
+	StatusResponse
+	Response *CheckForUpdateResponseBody `json:"response,omitempty"`
+}
+
+// CheckForUpdateResponseBody holds the version details.
+type CheckForUpdateResponseBody struct {
+	UpdateAvailable *bool   `json:"updateAvailable,omitempty"`
+	CurrentVersion  *string `json:"currentVersion,omitempty"`
+	UpdateVersion   *string `json:"updateVersion,omitempty"`
+	UpdateTitle     *string `json:"updateTitle,omitempty"`
+	UpdateMessage   *string `json:"updateMessage,omitempty"`
+}
+
+// GetDisplayName returns the DisplayName field value if set, zero value otherwise.
+func (o *LoginResponse) GetDisplayName() string {
+	if o == nil || o.DisplayName == nil {
+		var ret string
+		return ret
+	}
+	return *o.DisplayName
+}
+
+// GetUsername returns the Username field value if set, zero value otherwise.
+func (o *LoginResponse) GetUsername() string {
+	if o == nil || o.Username == nil {
+		var ret string
+		return ret
+	}
+	return *o.Username
+}
+
+// GetToken returns the Token field value if set, zero value otherwise.
+func (o *LoginResponse) GetToken() string {
+	if o == nil || o.Token == nil {
+		var ret string
+		return ret
+	}
+	return *o.Token
+}
+
+// GetInfo returns the Info field value if set, zero value otherwise.
+func (o *LoginResponse) GetInfo() SessionInfo {
+	if o == nil || o.Info == nil {
+		var ret SessionInfo
+		return ret
+	}
+	return *o.Info
+}
+
+// GetVersion returns the Version field value if set, zero value otherwise.
+func (o *SessionInfo) GetVersion() string {
+	if o == nil || o.Version == nil {
+		var ret string
+		return ret
+	}
+	return *o.Version
+}
+
+// GetUptimestamp returns the Uptimestamp field value if set, zero value otherwise.
+func (o *SessionInfo) GetUptimestamp() string {
+	if o == nil || o.Uptimestamp == nil {
+		var ret string
+		return ret
+	}
+	return *o.Uptimestamp
+}
+
+// GetDnsServerDomain returns the DnsServerDomain field value if set, zero value otherwise.
+func (o *SessionInfo) GetDnsServerDomain() string {
+	if o == nil || o.DnsServerDomain == nil {
+		var ret string
+		return ret
+	}
+	return *o.DnsServerDomain
+}
+
+// GetDefaultRecordTtl returns the DefaultRecordTtl field value if set, zero value otherwise.
+func (o *SessionInfo) GetDefaultRecordTtl() int32 {
+	if o == nil || o.DefaultRecordTtl == nil {
+		var ret int32
+		return ret
+	}
+	return *o.DefaultRecordTtl
+}
+
+// GetCanView returns the CanView field value if set, zero value otherwise.
+func (o *SessionPermission) GetCanView() bool {
+	if o == nil || o.CanView == nil {
+		var ret bool
+		return ret
+	}
+	return *o.CanView
+}
+
+// GetCanModify returns the CanModify field value if set, zero value otherwise.
+func (o *SessionPermission) GetCanModify() bool {
+	if o == nil || o.CanModify == nil {
+		var ret bool
+		return ret
+	}
+	return *o.CanModify
+}
+
+// GetCanDelete returns the CanDelete field value if set, zero value otherwise.
+func (o *SessionPermission) GetCanDelete() bool {
+	if o == nil || o.CanDelete == nil {
+		var ret bool
+		return ret
+	}
+	return *o.CanDelete
+}
+
+// GetUsername returns the Username field value if set, zero value otherwise.
+func (o *CreateTokenResponse) GetUsername() string {
+	if o == nil || o.Username == nil {
+		var ret string
+		return ret
+	}
+	return *o.Username
+}
+
+// GetTokenName returns the TokenName field value if set, zero value otherwise.
+func (o *CreateTokenResponse) GetTokenName() string {
+	if o == nil || o.TokenName == nil {
+		var ret string
+		return ret
+	}
+	return *o.TokenName
+}
+
+// GetToken returns the Token field value if set, zero value otherwise.
+func (o *CreateTokenResponse) GetToken() string {
+	if o == nil || o.Token == nil {
+		var ret string
+		return ret
+	}
+	return *o.Token
+}
+
+// GetResponse returns the Response field value if set, zero value otherwise.
+func (o *CheckForUpdateResponse) GetResponse() CheckForUpdateResponseBody {
+	if o == nil || o.Response == nil {
+		var ret CheckForUpdateResponseBody
+		return ret
+	}
+	return *o.Response
+}
+
+// GetUpdateAvailable returns the UpdateAvailable field value if set, zero value otherwise.
+func (o *CheckForUpdateResponseBody) GetUpdateAvailable() bool {
+	if o == nil || o.UpdateAvailable == nil {
+		var ret bool
+		return ret
+	}
+	return *o.UpdateAvailable
+}
+
+// GetCurrentVersion returns the CurrentVersion field value if set, zero value otherwise.
+func (o *CheckForUpdateResponseBody) GetCurrentVersion() string {
+	if o == nil || o.CurrentVersion == nil {
+		var ret string
+		return ret
+	}
+	return *o.CurrentVersion
+}
+
+// GetUpdateVersion returns the UpdateVersion field value if set, zero value otherwise.
+func (o *CheckForUpdateResponseBody) GetUpdateVersion() string {
+	if o == nil || o.UpdateVersion == nil {
+		var ret string
+		return ret
+	}
+	return *o.UpdateVersion
+}
+
+// GetUpdateTitle returns the UpdateTitle field value if set, zero value otherwise.
+func (o *CheckForUpdateResponseBody) GetUpdateTitle() string {
+	if o == nil || o.UpdateTitle == nil {
+		var ret string
+		return ret
+	}
+	return *o.UpdateTitle
+}
+
+// GetUpdateMessage returns the UpdateMessage field value if set, zero value otherwise.
+func (o *CheckForUpdateResponseBody) GetUpdateMessage() string {
+	if o == nil || o.UpdateMessage == nil {
+		var ret string
+		return ret
+	}
+	return *o.UpdateMessage
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+)
+
+// tlsSettings holds the TLS related provider configuration.
+type tlsSettings struct {
+	CACertFile         string
+	CACertPEM          string
+	ClientCertFile     string
+	ClientCertPEM      string
+	ClientKeyFile      string
+	ClientKeyPEM       string
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// newTLSConfig builds the TLS configuration for connections to the
+// Technitium web service. Certificates are given either as file paths or as
+// PEM content.
+func newTLSConfig(settings tlsSettings) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         settings.ServerName,
+		InsecureSkipVerify: settings.InsecureSkipVerify,
+	}
+
+	caCert, err := readPEM(settings.CACertPEM, settings.CACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading CA certificate: %w", err)
+	}
+	if caCert != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, errors.New("CA certificate does not contain any PEM encoded certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	clientCert, err := readPEM(settings.ClientCertPEM, settings.ClientCertFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading client certificate: %w", err)
+	}
+	clientKey, err := readPEM(settings.ClientKeyPEM, settings.ClientKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading client key: %w", err)
+	}
+	if clientCert != nil || clientKey != nil {
+		if clientCert == nil || clientKey == nil {
+			return nil, errors.New("client certificate and client key must be set together")
+		}
+		certificate, err := tls.X509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// readPEM returns the PEM content if set, otherwise the content of the file,
+// or nil if neither is set.
+func readPEM(content string, file string) ([]byte, error) {
+	if content != "" {
+		return []byte(content), nil
+	}
+	if file == "" {
+		return nil, nil
+	}
+	return os.ReadFile(file)
+}
+
+// newTransport returns a copy of the default transport using tlsConfig.
+func newTransport(tlsConfig *tls.Config) *http.Transport {
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		}
+	}
+
+	transport := defaultTransport.Clone()
+	transport.TLSClientConfig = tlsConfig
+	return transport
+}
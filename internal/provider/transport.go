@@ -0,0 +1,656 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxConcurrentRequests        = 10
+	defaultMaxConcurrentRequestsPerZone = 1
+	defaultMaxRetries                   = 3
+	defaultRequestTimeout               = 60 * time.Second
+	retryMinBackoff                     = 500 * time.Millisecond
+	retryMaxBackoff                     = 15 * time.Second
+
+	// maxLoggedBodySize bounds the response body written to trace logs.
+	maxLoggedBodySize = 64 * 1024
+)
+
+// CustomHTTPClient authenticates API calls with the API or session token.
+// When the provider logged in with username and password, a call rejected
+// with invalid-token renews the session once and is sent again.
+type CustomHTTPClient struct {
+	client *http.Client
+	// tokenInHeader sends the token as an Authorization bearer header
+	// instead of the token query parameter older servers require.
+	tokenInHeader bool
+	// login creates a new session; nil when a fixed API token is used.
+	login func(ctx context.Context) (string, error)
+	// renewing serializes session renewals.
+	renewing sync.Mutex
+
+	mu    sync.RWMutex
+	token string
+}
+
+func (c *CustomHTTPClient) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := c.currentToken()
+	resp, err := c.send(req, token)
+	if err != nil || c.login == nil || isSessionCall(req) || !isReplayable(req) {
+		return resp, err
+	}
+
+	body, err := bufferBody(resp)
+	if err != nil {
+		return resp, err
+	}
+	var status technitium.StatusResponse
+	if json.Unmarshal(body, &status) != nil || status.GetStatus() != statusInvalidToken {
+		return resp, nil
+	}
+
+	renewed, err := c.renewSession(req.Context(), token)
+	if err != nil {
+		// The invalid-token answer is reported to the caller as is.
+		tflog.Warn(req.Context(), "Could not renew expired Technitium session", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return resp, nil
+	}
+
+	retry := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return resp, nil
+		}
+	}
+	return c.send(retry, renewed)
+}
+
+// send adds the token to a copy of req and sends it.
+func (c *CustomHTTPClient) send(req *http.Request, token string) (*http.Response, error) {
+	// The login call itself is sent before a session token exists.
+	if token != "" && !isLoginCall(req) {
+		// A RoundTripper must not modify the caller's request.
+		req = req.Clone(req.Context())
+		if c.tokenInHeader {
+			req.Header.Set("Authorization", "Bearer "+token)
+		} else {
+			query := req.URL.Query()
+			query.Add("token", token)
+			req.URL.RawQuery = query.Encode()
+		}
+	}
+
+	if c.client.Transport == nil {
+		return http.DefaultTransport.RoundTrip(req)
+	}
+	return c.client.Transport.RoundTrip(req)
+}
+
+func (c *CustomHTTPClient) currentToken() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.token
+}
+
+func (c *CustomHTTPClient) setToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.token = token
+}
+
+// renewSession logs in again unless another call already replaced the
+// expired token, and returns the token to use.
+func (c *CustomHTTPClient) renewSession(ctx context.Context, expired string) (string, error) {
+	c.renewing.Lock()
+	defer c.renewing.Unlock()
+
+	if current := c.currentToken(); current != expired {
+		return current, nil
+	}
+
+	tflog.Info(ctx, "Technitium session expired, logging in again")
+	token, err := c.login(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.setToken(token)
+	return token, nil
+}
+
+// isLoginCall reports whether the call creates a session from credentials.
+func isLoginCall(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, "/api/user/login") ||
+		strings.HasSuffix(req.URL.Path, "/api/user/createToken")
+}
+
+// isSessionCall reports whether the call manages or inspects the session
+// itself, which must not trigger a session renewal.
+func isSessionCall(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, "/api/user/logout") ||
+		strings.HasSuffix(req.URL.Path, "/api/user/session/get") ||
+		isLoginCall(req)
+}
+
+// isReplayable reports whether the request body can be sent again.
+func isReplayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// retryTransport retries API calls that fail with a network error or a 5xx
+// response, waiting an exponentially growing, jittered delay between
+// attempts. Only calls that are safe to repeat are retried, except for
+// connection failures where the request never reached the server. It wraps
+// the concurrency limits, so a call waiting for its next attempt does not
+// hold a slot.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	idempotent := isIdempotent(req)
+	replayable := isReplayable(req)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := attemptRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		retry := replayable && attempt < t.maxRetries && req.Context().Err() == nil && shouldRetry(resp, err, idempotent)
+		if !retry {
+			return resp, err
+		}
+
+		var reason string
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		delay := backoff(attempt)
+		tflog.Debug(req.Context(), "Retrying Technitium API request", map[string]interface{}{
+			"endpoint": req.URL.Path,
+			"attempt":  attempt + 1,
+			"reason":   reason,
+			"delay":    delay.String(),
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attemptRequest returns a copy of req for the given attempt with a fresh
+// body.
+func attemptRequest(req *http.Request, attempt int) (*http.Request, error) {
+	attemptReq := req.Clone(req.Context())
+	if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		attemptReq.Body = body
+	}
+	return attemptReq, nil
+}
+
+// timeoutTransport bounds each attempt of an API call once it holds its
+// concurrency slots, so time spent waiting for a slot does not count.
+type timeoutTransport struct {
+	next http.RoundTripper
+	// timeout is zero for no limit.
+	timeout time.Duration
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// shouldRetry reports whether a failed attempt may be repeated.
+func shouldRetry(resp *http.Response, err error, idempotent bool) bool {
+	if err != nil {
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			// The connection was never established, so the server did
+			// not see the request.
+			return true
+		}
+		return idempotent
+	}
+
+	return idempotent && resp.StatusCode >= http.StatusInternalServerError
+}
+
+// isReadOnly reports whether the call only reads from the server. Technitium
+// sends every call as GET, so this is decided by endpoint.
+func isReadOnly(req *http.Request) bool {
+	switch path.Base(req.URL.Path) {
+	case "list", "get", "state", "listStoreApps", "viewDS", "export", "download", "query", "checkForUpdate":
+		return true
+	}
+	return false
+}
+
+// isIdempotent reports whether repeating the call has no further effect:
+// reads, and writes that set absolute values. Calls that add or delete
+// objects are not, as a repeat fails or duplicates the change.
+func isIdempotent(req *http.Request) bool {
+	switch path.Base(req.URL.Path) {
+	case "set", "enable", "disable":
+		return true
+	}
+	return isReadOnly(req)
+}
+
+// backoff returns the delay before the retry following attempt: an
+// exponentially growing delay with the upper half randomized.
+func backoff(attempt int) time.Duration {
+	delay := retryMaxBackoff
+	if attempt < 16 {
+		delay = min(retryMinBackoff<<attempt, retryMaxBackoff)
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// cancelOnClose releases the context of an attempt once the caller has read
+// the response body.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// limitTransport caps the number of API calls in flight, both in total and
+// for writes to the same zone. Technitium rewrites the zone file on every
+// change, so concurrent writes to one zone are serialized by default while
+// different zones still proceed in parallel. Zone writes are the calls of
+// the zones, records and DNSSEC APIs and changes to the allowed and blocked
+// zones; other writes such as settings, apps or DHCP scopes only count
+// against the global limit.
+type limitTransport struct {
+	next http.RoundTripper
+	// global limits all calls; nil means no limit.
+	global chan struct{}
+	// perZone is the number of concurrent writes allowed per zone; zero
+	// means no limit.
+	perZone int
+
+	mu    sync.Mutex
+	zones map[string]chan struct{}
+}
+
+func newLimitTransport(next http.RoundTripper, maxConcurrent int, maxConcurrentPerZone int) *limitTransport {
+	t := &limitTransport{
+		next:    next,
+		perZone: maxConcurrentPerZone,
+		zones:   make(map[string]chan struct{}),
+	}
+	if maxConcurrent > 0 {
+		t.global = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.perZone > 0 && !isReadOnly(req) {
+		if zone := writeZone(req); zone != "" {
+			release, err := acquire(req.Context(), t.zoneSlots(zone))
+			if err != nil {
+				return nil, err
+			}
+			defer release()
+		}
+	}
+
+	if t.global != nil {
+		release, err := acquire(req.Context(), t.global)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// zoneSlots returns the semaphore of a zone, creating it on first use.
+func (t *limitTransport) zoneSlots(zone string) chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	slots, ok := t.zones[zone]
+	if !ok {
+		slots = make(chan struct{}, t.perZone)
+		t.zones[zone] = slots
+	}
+	return slots
+}
+
+// acquire takes a slot of the semaphore, giving up when ctx is done.
+func acquire(ctx context.Context, slots chan struct{}) (func(), error) {
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Keys of the built-in allowed and blocked zones, which cannot clash with
+// zone names.
+const (
+	allowedZoneKey = "allowed zone"
+	blockedZoneKey = "blocked zone"
+)
+
+// writeZone returns the normalized zone a call writes to, with the
+// parameters read from the query string or the form body, or "" when the
+// call is not a zone write. A record call that names only its domain is
+// keyed on the domain itself: the limiter cannot tell which zone holds it,
+// and keying on the parent domains would serialize every zone under the
+// same top level domain.
+func writeZone(req *http.Request) string {
+	switch {
+	case strings.Contains(req.URL.Path, "/api/allowed/"):
+		return allowedZoneKey
+	case strings.Contains(req.URL.Path, "/api/blocked/"):
+		return blockedZoneKey
+	case !strings.Contains(req.URL.Path, "/api/zones/"):
+		return ""
+	}
+
+	params := requestParameters(req)
+	if zone := normalizeZone(params.Get("zone")); zone != "" {
+		return zone
+	}
+	return normalizeZone(params.Get("domain"))
+}
+
+func normalizeZone(zone string) string {
+	return strings.TrimSuffix(strings.ToLower(zone), ".")
+}
+
+// sensitiveParameters are the API parameters whose values are masked in logs.
+var sensitiveParameters = []string{"token", "pass", "newPass", "totp", "pemKskPrivateKey", "pemZskPrivateKey", "sharedSecret", "primaryNodePassword", "primaryNodeTotp"}
+
+// tokenInBody matches the session token returned in login responses.
+var tokenInBody = regexp.MustCompile(`"token"\s*:\s*"[^"]*"`)
+
+// loggingTransport writes a debug log entry for every API call and its
+// response. Parameters are logged as param_<name> fields; sensitive ones,
+// and any occurrence of the configured secrets, are masked by tflog.
+type loggingTransport struct {
+	next http.RoundTripper
+	// secrets returns the credentials in use, masked wherever they appear.
+	secrets func() []string
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := t.maskedContext(req.Context())
+
+	fields := map[string]interface{}{
+		"method":   req.Method,
+		"endpoint": req.URL.Path,
+	}
+	for key, values := range requestParameters(req) {
+		fields["param_"+key] = strings.Join(values, ",")
+	}
+	tflog.Debug(ctx, "Sending Technitium API request", fields)
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+
+	fields = map[string]interface{}{
+		"method":      req.Method,
+		"endpoint":    req.URL.Path,
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Technitium API request failed", fields)
+		return resp, err
+	}
+
+	body, err := bufferBody(resp)
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Technitium API response could not be read", fields)
+		return resp, err
+	}
+
+	fields["status_code"] = resp.StatusCode
+	fields["server"] = servedBy(resp)
+	var status technitium.StatusResponse
+	if json.Unmarshal(body, &status) == nil && status.Status != nil {
+		fields["technitium_status"] = status.GetStatus()
+		if status.ErrorMessage != nil {
+			fields["technitium_error_message"] = status.GetErrorMessage()
+		}
+	}
+	tflog.Debug(ctx, "Received Technitium API response", fields)
+
+	if len(body) > maxLoggedBodySize {
+		body = body[:maxLoggedBodySize]
+	}
+	tflog.Trace(ctx, "Technitium API response body", map[string]interface{}{
+		"endpoint": req.URL.Path,
+		"body":     string(body),
+	})
+
+	return resp, nil
+}
+
+// maskedContext returns ctx with masking of secrets configured.
+func (t *loggingTransport) maskedContext(ctx context.Context) context.Context {
+	keys := make([]string, 0, len(sensitiveParameters))
+	for _, name := range sensitiveParameters {
+		keys = append(keys, "param_"+name)
+	}
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, keys...)
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, tokenInBody)
+
+	var secrets []string
+	if t.secrets != nil {
+		for _, secret := range t.secrets() {
+			// Masking an empty string would mask between every character.
+			if secret != "" {
+				secrets = append(secrets, secret)
+			}
+		}
+	}
+	if len(secrets) > 0 {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, secrets...)
+		ctx = tflog.MaskMessageStrings(ctx, secrets...)
+	}
+
+	return ctx
+}
+
+// requestParameters returns the parameters of a call, from the query string
+// and from a form body.
+func requestParameters(req *http.Request) url.Values {
+	params := req.URL.Query()
+
+	if req.GetBody == nil || !strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		return params
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return params
+	}
+	defer body.Close()
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return params
+	}
+	form, err := url.ParseQuery(string(content))
+	if err != nil {
+		return params
+	}
+	for key, values := range form {
+		params[key] = append(params[key], values...)
+	}
+	return params
+}
+
+// bufferBody reads the response body so it can be inspected, and replaces it
+// with an in-memory copy for the caller.
+func bufferBody(resp *http.Response) ([]byte, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return body, err
+}
+
+// failoverTransport sends API calls to the first reachable of several
+// endpoints serving the same Technitium API. Requests are built for the
+// first endpoint and rewritten to the endpoint in use, which stays in use
+// until it fails. A call moves on to the next endpoint when the connection
+// fails; calls that are not safe to repeat only do so when the connection
+// could not be established at all.
+type failoverTransport struct {
+	next      http.RoundTripper
+	endpoints []*url.URL
+
+	mu     sync.Mutex
+	active int
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	idempotent := isIdempotent(req)
+	replayable := isReplayable(req)
+	start := t.current()
+
+	var errs []error
+	for i := range t.endpoints {
+		index := (start + i) % len(t.endpoints)
+		endpointReq, err := t.rewrite(req, index, i > 0)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(endpointReq)
+		if err == nil {
+			if index != start {
+				t.setActive(index)
+			}
+			return resp, nil
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %w", t.endpoints[index].Redacted(), err))
+		if i == len(t.endpoints)-1 || !replayable || req.Context().Err() != nil || !shouldRetry(nil, err, idempotent) {
+			break
+		}
+
+		tflog.Warn(req.Context(), "Technitium endpoint failed, trying the next one", map[string]interface{}{
+			"endpoint":      req.URL.Path,
+			"failed_server": t.endpoints[index].Redacted(),
+			"next_server":   t.endpoints[(index+1)%len(t.endpoints)].Redacted(),
+			"error":         err.Error(),
+		})
+	}
+
+	// The joined error keeps the underlying errors inspectable, so the
+	// retry transport still recognizes connection failures.
+	return nil, errors.Join(errs...)
+}
+
+func (t *failoverTransport) current() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.active
+}
+
+func (t *failoverTransport) setActive(index int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.active = index
+}
+
+// rewrite returns a copy of req addressed to the endpoint at index, with a
+// fresh body when the request is sent again.
+func (t *failoverTransport) rewrite(req *http.Request, index int, resend bool) (*http.Request, error) {
+	endpointReq := req.Clone(req.Context())
+	if resend && req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		endpointReq.Body = body
+	}
+	if index == 0 {
+		return endpointReq, nil
+	}
+
+	base, endpoint := t.endpoints[0], t.endpoints[index]
+	apiPath := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(base.Path, "/"))
+	endpointReq.URL.Scheme = endpoint.Scheme
+	endpointReq.URL.Host = endpoint.Host
+	endpointReq.URL.User = endpoint.User
+	endpointReq.URL.Path = strings.TrimSuffix(endpoint.Path, "/") + apiPath
+	endpointReq.URL.RawPath = ""
+	endpointReq.Host = ""
+
+	return endpointReq, nil
+}
+
+// servedBy returns the server that answered resp, for logging.
+func servedBy(resp *http.Response) string {
+	if resp == nil || resp.Request == nil {
+		return ""
+	}
+	server := *resp.Request.URL
+	server.Path, server.RawPath, server.RawQuery, server.Fragment = "", "", "", ""
+	return server.Redacted()
+}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+)
+
+// newFailoverClient returns a client that fails over between the given
+// endpoints and authenticates with the fake API token.
+func newFailoverClient(t *testing.T, endpoints ...string) (*technitium.APIClient, *failoverTransport) {
+	t.Helper()
+
+	failover := &failoverTransport{next: http.DefaultTransport}
+	for _, endpoint := range endpoints {
+		endpointURL, err := url.Parse(endpoint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		failover.endpoints = append(failover.endpoints, endpointURL)
+	}
+
+	cfg := technitium.NewConfiguration()
+	cfg.Servers = technitium.ServerConfigurations{{URL: endpoints[0]}}
+	cfg.HTTPClient = &http.Client{
+		Transport: &CustomHTTPClient{
+			client: &http.Client{
+				Transport: failover,
+			},
+			token: fakeAPIToken,
+		},
+	}
+
+	return technitium.NewAPIClient(cfg), failover
+}
+
+// unreachableURL returns the URL of a server that refuses connections.
+func unreachableURL() string {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	return server.URL
+}
+
+// newHangUpServer returns a server that accepts connections and closes them
+// without answering.
+func newHangUpServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			t.Error("response writer cannot be hijacked")
+			return
+		}
+		conn, _, err := hijacker.Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		conn.Close()
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFailoverTransport(t *testing.T) {
+	server := newFakeServer(t)
+	client, failover := newFailoverClient(t, unreachableURL(), server.URL+"/")
+
+	answ, resp, err := client.DnsZoneAPI.CreateDnsZone(context.Background()).Zone("example.com").Type_("Primary").Execute()
+	if err = checkResponse(answ, err); err != nil {
+		t.Fatalf("expected the call to fail over, got: %s", err)
+	}
+	if served := servedBy(resp); served != server.URL {
+		t.Errorf("call served by %s, expected %s", served, server.URL)
+	}
+	if failover.current() != 1 {
+		t.Errorf("expected the second endpoint to stay in use, got endpoint %d", failover.current())
+	}
+}
+
+func TestFailoverTransportAfterConnecting(t *testing.T) {
+	server := newFakeServer(t)
+	hangUp := newHangUpServer(t)
+
+	// Reads move on to the next endpoint when the connection breaks.
+	client, _ := newFailoverClient(t, hangUp.URL, server.URL)
+	answ, _, err := client.DnsZoneAPI.ListDnsZones(context.Background()).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		t.Fatalf("expected the read to fail over, got: %s", err)
+	}
+
+	// Writes that are not safe to repeat may have reached the server, so
+	// they do not.
+	client, failover := newFailoverClient(t, hangUp.URL, server.URL)
+	_, _, err = client.DnsZoneAPI.CreateDnsZone(context.Background()).Zone("example.com").Type_("Primary").Execute()
+	if err == nil || !strings.Contains(err.Error(), hangUp.URL) {
+		t.Fatalf("expected the error of %s, got: %v", hangUp.URL, err)
+	}
+	if failover.current() != 0 {
+		t.Errorf("expected the first endpoint to stay in use, got endpoint %d", failover.current())
+	}
+	if server.zone("example.com") != nil {
+		t.Error("zone was created on the second endpoint")
+	}
+}
+
+func TestFailoverTransportAllFailed(t *testing.T) {
+	first, second := unreachableURL(), unreachableURL()
+	client, _ := newFailoverClient(t, first, second)
+
+	_, _, err := client.DnsZoneAPI.ListDnsZones(context.Background()).Execute()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, endpoint := range []string{first, second} {
+		if !strings.Contains(err.Error(), endpoint) {
+			t.Errorf("error does not name endpoint %s: %s", endpoint, err)
+		}
+	}
+}
+
+func TestWriteZone(t *testing.T) {
+	form := func(path string, params url.Values) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, "https://dns.example"+path, strings.NewReader(params.Encode()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		return req
+	}
+	query := func(path string, params url.Values) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, "https://dns.example"+path+"?"+params.Encode(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+
+	tests := map[string]struct {
+		req      *http.Request
+		expected string
+	}{
+		"zone call": {
+			req:      query("/api/zones/create", url.Values{"zone": {"Example.com."}}),
+			expected: "example.com",
+		},
+		"record call naming its zone": {
+			req:      query("/api/zones/records/add", url.Values{"zone": {"example.com"}, "domain": {"www.example.com"}}),
+			expected: "example.com",
+		},
+		"record call naming only its domain": {
+			req:      query("/api/zones/records/add", url.Values{"domain": {"www.example.com"}}),
+			expected: "www.example.com",
+		},
+		"zone in the form body": {
+			req:      form("/api/zones/records/delete", url.Values{"zone": {"example.com"}, "domain": {"www.example.com"}}),
+			expected: "example.com",
+		},
+		"allowed zone": {
+			req:      query("/api/allowed/add", url.Values{"domain": {"ads.example.com"}}),
+			expected: allowedZoneKey,
+		},
+		"blocked zone": {
+			req:      query("/api/blocked/delete", url.Values{"domain": {"ads.example.com"}}),
+			expected: blockedZoneKey,
+		},
+		"settings": {
+			req: form("/api/settings/set", url.Values{"dnsServerDomain": {"dns.example.com"}}),
+		},
+		"app config": {
+			req: form("/api/apps/config/set", url.Values{"name": {"Split Horizon"}, "config": {"{}"}}),
+		},
+		"cache": {
+			req: query("/api/cache/delete", url.Values{"domain": {"example.com"}}),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if zone := writeZone(test.req); zone != test.expected {
+				t.Errorf("expected zone %q, got %q", test.expected, zone)
+			}
+		})
+	}
+}
+
+// peakTransport answers every call after a short delay and records the
+// largest number of calls in flight at once.
+type peakTransport struct {
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+}
+
+func (t *peakTransport) RoundTrip(_ *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.inFlight++
+	t.peak = max(t.peak, t.inFlight)
+	t.mu.Unlock()
+
+	time.Sleep(50 * time.Millisecond)
+
+	t.mu.Lock()
+	t.inFlight--
+	t.mu.Unlock()
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+}
+
+func TestLimitTransportPerZone(t *testing.T) {
+	tests := map[string]struct {
+		urls     []string
+		expected int
+	}{
+		"same zone": {
+			urls: []string{
+				"https://dns.example/api/zones/records/add?zone=example.com&domain=www.example.com",
+				"https://dns.example/api/zones/records/add?zone=example.com&domain=mail.example.com",
+			},
+			expected: 1,
+		},
+		"same domain": {
+			urls: []string{
+				"https://dns.example/api/zones/records/add?domain=www.example.com",
+				"https://dns.example/api/zones/records/delete?domain=www.example.com",
+			},
+			expected: 1,
+		},
+		"different zones under the same top level domain": {
+			urls: []string{
+				"https://dns.example/api/zones/records/add?domain=a.example.com",
+				"https://dns.example/api/zones/records/add?domain=b.other.com",
+			},
+			expected: 2,
+		},
+		"different zones": {
+			urls: []string{
+				"https://dns.example/api/zones/records/add?zone=example.com&domain=www.example.com",
+				"https://dns.example/api/zones/records/add?zone=example.org&domain=www.example.org",
+			},
+			expected: 2,
+		},
+		"reads": {
+			urls: []string{
+				"https://dns.example/api/zones/records/get?zone=example.com&domain=www.example.com",
+				"https://dns.example/api/zones/records/get?zone=example.com&domain=mail.example.com",
+			},
+			expected: 2,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			next := &peakTransport{}
+			limit := newLimitTransport(next, 0, 1)
+
+			var wg sync.WaitGroup
+			for _, u := range test.urls {
+				req, err := http.NewRequest(http.MethodGet, u, nil)
+				if err != nil {
+					t.Fatal(err)
+				}
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := limit.RoundTrip(req); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if next.peak != test.expected {
+				t.Errorf("expected %d calls in flight at most, got %d", test.expected, next.peak)
+			}
+		})
+	}
+}
+
+// transportFunc answers calls with a function.
+type transportFunc func(*http.Request) (*http.Response, error)
+
+func (f transportFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransportReleasesSlots(t *testing.T) {
+	failed := make(chan struct{})
+	var once sync.Once
+	limit := newLimitTransport(transportFunc(func(req *http.Request) (*http.Response, error) {
+		status := http.StatusOK
+		if req.URL.Path == "/api/zones/list" {
+			once.Do(func() {
+				status = http.StatusServiceUnavailable
+				close(failed)
+			})
+		}
+		return &http.Response{StatusCode: status, Status: http.StatusText(status), Body: http.NoBody}, nil
+	}), 1, 0)
+	retry := &retryTransport{next: limit, maxRetries: 1}
+
+	retried := make(chan error, 1)
+	go func() {
+		req, err := http.NewRequest(http.MethodGet, "https://dns.example/api/zones/list", nil)
+		if err != nil {
+			retried <- err
+			return
+		}
+		resp, err := retry.RoundTrip(req)
+		if err == nil && resp.StatusCode != http.StatusOK {
+			err = fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		retried <- err
+	}()
+
+	// The only slot is free while the first call waits to be retried.
+	<-failed
+	ctx, cancel := context.WithTimeout(context.Background(), retryMinBackoff/5)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://dns.example/api/settings/get", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := limit.RoundTrip(req); err != nil {
+		t.Errorf("expected the slot to be free during the backoff, got: %s", err)
+	}
+
+	if err := <-retried; err != nil {
+		t.Errorf("retried call failed: %s", err)
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = ipv4AddressValidator{}
+
+// ipv4AddressValidator checks that a string is an IPv4 address, as used by
+// the DHCP server.
+type ipv4AddressValidator struct{}
+
+// ipv4Address returns a validator for IPv4 address attributes.
+func ipv4Address() validator.String {
+	return ipv4AddressValidator{}
+}
+
+func (v ipv4AddressValidator) Description(_ context.Context) string {
+	return "value must be an IPv4 address"
+}
+
+func (v ipv4AddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipv4AddressValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if addr, err := netip.ParseAddr(value); err != nil || !addr.Is4() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IPv4 Address",
+			fmt.Sprintf("Attribute %s value must be an IPv4 address, got: %s", req.Path, value),
+		)
+	}
+}
+
+var _ validator.String = networkACLEntryValidator{}
+
+// networkACLEntryValidator checks that a string is an entry of a network
+// access control list: an address or a network in CIDR notation, prefixed
+// with "!" to deny it.
+type networkACLEntryValidator struct{}
+
+// networkACLEntry returns a validator for network access control list entries.
+func networkACLEntry() validator.String {
+	return networkACLEntryValidator{}
+}
+
+func (v networkACLEntryValidator) Description(_ context.Context) string {
+	return `value must be an IP address or a network in CIDR notation, optionally prefixed with "!"`
+}
+
+func (v networkACLEntryValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v networkACLEntryValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	network := strings.TrimPrefix(value, "!")
+	if _, err := netip.ParsePrefix(network); err == nil {
+		return
+	}
+	if _, err := netip.ParseAddr(network); err == nil {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Network ACL Entry",
+		fmt.Sprintf(`Attribute %s value must be an IP address or a network in CIDR notation, optionally prefixed with "!" to deny it, got: %s`, req.Path, value),
+	)
+}
+
+var _ validator.String = ipAddressValidator{}
+
+// ipAddressValidator checks that a string is an IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+// ipAddress returns a validator for IP address attributes.
+func ipAddress() validator.String {
+	return ipAddressValidator{}
+}
+
+func (v ipAddressValidator) Description(_ context.Context) string {
+	return "value must be an IP address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := netip.ParseAddr(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Attribute %s value must be an IP address, got: %s", req.Path, value),
+		)
+	}
+}
+
+var _ validator.String = httpURLValidator{}
+
+// httpURLValidator checks that a string is an absolute http or https URL,
+// which the server can download from.
+type httpURLValidator struct{}
+
+// httpURL returns a validator for download URL attributes.
+func httpURL() validator.String {
+	return httpURLValidator{}
+}
+
+func (v httpURLValidator) Description(_ context.Context) string {
+	return "value must be an http or https URL"
+}
+
+func (v httpURLValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v httpURLValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("Attribute %s value must be an http or https URL, got: %s", req.Path, value),
+		)
+	}
+}
+
+var _ validator.String = jsonDocumentValidator{}
+
+// jsonDocumentValidator checks that a string is a JSON document.
+type jsonDocumentValidator struct{}
+
+// jsonDocument returns a validator for attributes holding JSON.
+func jsonDocument() validator.String {
+	return jsonDocumentValidator{}
+}
+
+func (v jsonDocumentValidator) Description(_ context.Context) string {
+	return "value must be a JSON document"
+}
+
+func (v jsonDocumentValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonDocumentValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Document",
+			fmt.Sprintf("Attribute %s value must be a JSON document", req.Path),
+		)
+	}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"terraform-provider-technitium/internal/provider/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// technitiumClient is the API client handed to resources and data sources,
+// together with what the provider learned about the server at Configure.
+type technitiumClient struct {
+	*technitium.APIClient
+
+	// version is the server version, or the zero value if it could not be
+	// detected.
+	version serverVersion
+}
+
+// serverVersion is a Technitium DNS Server version such as 13.6 or 12.2.1.
+type serverVersion struct {
+	major, minor, patch int
+	raw                 string
+}
+
+// parseServerVersion parses the version reported by the server.
+func parseServerVersion(raw string) (serverVersion, error) {
+	parts := strings.Split(strings.TrimSpace(raw), ".")
+	if len(parts) < 2 || len(parts) > 4 {
+		return serverVersion{}, fmt.Errorf("unexpected server version %q", raw)
+	}
+
+	var numbers [3]int
+	for i := 0; i < len(parts) && i < len(numbers); i++ {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil || n < 0 {
+			return serverVersion{}, fmt.Errorf("unexpected server version %q", raw)
+		}
+		numbers[i] = n
+	}
+
+	return serverVersion{
+		major: numbers[0],
+		minor: numbers[1],
+		patch: numbers[2],
+		raw:   raw,
+	}, nil
+}
+
+// known reports whether the version was detected.
+func (v serverVersion) known() bool {
+	return v.raw != ""
+}
+
+// atLeast reports whether the version is major.minor or later.
+func (v serverVersion) atLeast(major, minor int) bool {
+	if v.major != major {
+		return v.major > major
+	}
+	return v.minor >= minor
+}
+
+func (v serverVersion) String() string {
+	if !v.known() {
+		return "unknown"
+	}
+	return v.raw
+}
+
+// serverFeature is a provider feature that needs a minimum server version.
+type serverFeature struct {
+	name         string
+	major, minor int
+}
+
+var (
+	featureCatalogZones = serverFeature{name: "Catalog, SecondaryCatalog and SecondaryForwarder zones", major: 13, minor: 0}
+	featureClustering   = serverFeature{name: "Clusters", major: 14, minor: 0}
+)
+
+// requireFeature adds an error at attribute when the server is too old for
+// feature. Nothing is reported when the version could not be detected; the
+// server then rejects what it does not support.
+func (c *technitiumClient) requireFeature(diags *diag.Diagnostics, attribute path.Path, feature serverFeature) {
+	if !c.version.known() || c.version.atLeast(feature.major, feature.minor) {
+		return
+	}
+
+	diags.AddAttributeError(
+		attribute,
+		"Unsupported Technitium Server Version",
+		fmt.Sprintf("%s require Technitium DNS Server %d.%d or later, but the server runs version %s. "+
+			"Upgrade the server or change the configuration to not use this feature.",
+			feature.name, feature.major, feature.minor, c.version),
+	)
+}
+
+// detectServerVersion asks the server for its version. Failures are logged
+// and leave the version unknown, as older servers or restricted users may
+// not be able to answer.
+func detectServerVersion(ctx context.Context, client *technitium.APIClient) serverVersion {
+	answ, _, err := client.UserAPI.GetSession(ctx).Execute()
+	if err = checkResponse(answ, err); err != nil {
+		tflog.Warn(ctx, "Could not detect the Technitium server version", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return serverVersion{}
+	}
+
+	version, err := parseServerVersion(answ.Info.GetVersion())
+	if err != nil {
+		tflog.Warn(ctx, "Could not detect the Technitium server version", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return serverVersion{}
+	}
+
+	tflog.Info(ctx, "Detected Technitium server version", map[string]interface{}{
+		"version": version.String(),
+	})
+	return version
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	tests := []struct {
+		raw                 string
+		major, minor, patch int
+		wantErr             bool
+	}{
+		{raw: "13.6", major: 13, minor: 6},
+		{raw: "12.2.1", major: 12, minor: 2, patch: 1},
+		{raw: "11.0.0.0", major: 11},
+		{raw: "13", wantErr: true},
+		{raw: "", wantErr: true},
+		{raw: "v13.1", wantErr: true},
+	}
+
+	for _, test := range tests {
+		version, err := parseServerVersion(test.raw)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("parseServerVersion(%q): expected an error", test.raw)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseServerVersion(%q): unexpected error: %s", test.raw, err)
+			continue
+		}
+		if version.major != test.major || version.minor != test.minor || version.patch != test.patch {
+			t.Errorf("parseServerVersion(%q) = %d.%d.%d", test.raw, version.major, version.minor, version.patch)
+		}
+	}
+}
+
+func TestServerVersionAtLeast(t *testing.T) {
+	version, err := parseServerVersion("13.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		major, minor int
+		want         bool
+	}{
+		{12, 9, true},
+		{13, 0, true},
+		{13, 2, true},
+		{13, 3, false},
+		{14, 0, false},
+	} {
+		if got := version.atLeast(test.major, test.minor); got != test.want {
+			t.Errorf("13.2 atLeast %d.%d = %t", test.major, test.minor, got)
+		}
+	}
+}
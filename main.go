@@ -8,6 +8,7 @@ import (
 	"flag"
 	"log"
 	"terraform-provider-technitium/internal/provider"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
@@ -39,6 +40,12 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Serve returns once Terraform stops the provider; log out of any
+	// session opened with username and password authentication.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	provider.CloseSessions(ctx)
+	cancel()
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}